@@ -0,0 +1,212 @@
+package github
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// appCredentials are the credentials used to authenticate as an installation of a GitHub App
+type appCredentials struct {
+	AppID          int64
+	InstallationID int64
+	PrivateKey     *rsa.PrivateKey
+}
+
+// getAppCredentials returns the GitHub App credentials from the connection config, falling back to the
+// GITHUB_APP_ID, GITHUB_APP_INSTALLATION_ID and GITHUB_APP_PRIVATE_KEY environment variables. It returns nil if no
+// app is configured.
+func getAppCredentials(config githubConfig) (*appCredentials, error) {
+	appID, err := int64FromConfigOrEnv(config.AppID, "GITHUB_APP_ID")
+	if err != nil {
+		return nil, err
+	}
+	installationID, err := int64FromConfigOrEnv(config.InstallationID, "GITHUB_APP_INSTALLATION_ID")
+	if err != nil {
+		return nil, err
+	}
+	privateKey := os.Getenv("GITHUB_APP_PRIVATE_KEY")
+	if config.PrivateKey != nil {
+		privateKey = *config.PrivateKey
+	}
+
+	if appID == 0 && installationID == 0 && privateKey == "" {
+		return nil, nil
+	}
+	if appID == 0 || installationID == 0 || privateKey == "" {
+		return nil, fmt.Errorf("'app_id', 'installation_id' and 'private_key' must all be set to authenticate as a GitHub App")
+	}
+
+	key, err := parseAppPrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &appCredentials{AppID: appID, InstallationID: installationID, PrivateKey: key}, nil
+}
+
+func int64FromConfigOrEnv(value *int64, env string) (int64, error) {
+	if value != nil {
+		return *value, nil
+	}
+	if s := os.Getenv(env); s != "" {
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%s is invalid: %s", env, s)
+		}
+		return i, nil
+	}
+	return 0, nil
+}
+
+// parseAppPrivateKey parses the private key of a GitHub App, which is either given as PEM or as the path to a PEM file
+func parseAppPrivateKey(value string) (*rsa.PrivateKey, error) {
+	data := []byte(value)
+	if !strings.HasPrefix(strings.TrimSpace(value), "-----BEGIN") {
+		path, err := expandHomeDir(value)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read GitHub App private key: %v", err)
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read GitHub App private key: %v", err)
+		}
+		data = content
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("GitHub App private key is not PEM encoded")
+	}
+
+	// GitHub generates PKCS#1 keys, but keys converted to PKCS#8 are accepted too
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse GitHub App private key: %v", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("GitHub App private key must be an RSA key")
+	}
+	return key, nil
+}
+
+// newAppJWT returns a JSON Web Token signed with the private key of the app, used to authenticate as the app itself
+func newAppJWT(creds *appCredentials, now time.Time) (string, error) {
+	header := map[string]string{"alg": "RS256", "typ": "JWT"}
+	// The issued at time is set in the past to allow for clock drift, and
+	// GitHub rejects tokens which expire more than 10 minutes in the future
+	claims := map[string]interface{}{
+		"iat": now.Add(-60 * time.Second).Unix(),
+		"exp": now.Add(9 * time.Minute).Unix(),
+		"iss": strconv.FormatInt(creds.AppID, 10),
+	}
+
+	var segments []string
+	for _, part := range []interface{}{header, claims} {
+		data, err := json.Marshal(part)
+		if err != nil {
+			return "", err
+		}
+		segments = append(segments, base64.RawURLEncoding.EncodeToString(data))
+	}
+
+	signingInput := strings.Join(segments, ".")
+	hash := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, creds.PrivateKey, crypto.SHA256, hash[:])
+	if err != nil {
+		return "", err
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// createInstallationToken exchanges a JSON Web Token of the app for an installation access token
+func createInstallationToken(ctx context.Context, creds *appCredentials, baseURL string) (*oauth2.Token, error) {
+	jwt, err := newAppJWT(creds, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint, err := restAPIURL(baseURL)
+	if err != nil {
+		return nil, err
+	}
+	endpoint = endpoint.JoinPath("app", "installations", strconv.FormatInt(creds.InstallationID, 10), "access_tokens")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+jwt)
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("unable to create GitHub App installation token: %s", resp.Status)
+	}
+
+	var result struct {
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &oauth2.Token{AccessToken: result.Token, Expiry: result.ExpiresAt}, nil
+}
+
+// installationTokenRefreshWindow is how long before their expiry installation tokens are refreshed, so that requests of
+// long running scans are not made with a token which expires in flight
+const installationTokenRefreshWindow = 5 * time.Minute
+
+// installationTokenSource mints a new JSON Web Token for the app and exchanges it for an installation token each time
+// a token is requested. It is wrapped in a reusing token source so this only happens when the token is about to expire.
+type installationTokenSource struct {
+	creds   *appCredentials
+	baseURL string
+}
+
+func (s *installationTokenSource) Token() (*oauth2.Token, error) {
+	// The token source outlives the query which created it
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	return createInstallationToken(ctx, s.creds, s.baseURL)
+}
+
+func newInstallationTokenSource(creds *appCredentials, baseURL string) oauth2.TokenSource {
+	return oauth2.ReuseTokenSourceWithExpiry(nil, &installationTokenSource{creds: creds, baseURL: baseURL}, installationTokenRefreshWindow)
+}
+
+// restAPIURL returns the root of the REST API for the given base URL, which is empty for GitHub cloud
+func restAPIURL(baseURL string) (*url.URL, error) {
+	if baseURL == "" {
+		baseURL = "https://api.github.com/"
+	}
+	restURL, _, err := enterpriseAPIURLs(baseURL)
+	return restURL, err
+}
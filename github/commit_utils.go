@@ -0,0 +1,42 @@
+package github
+
+import (
+	"slices"
+
+	"github.com/shurcooL/githubv4"
+)
+
+func appendCommitColumnIncludes(m *map[string]interface{}, cols []string) {
+	optionals := map[string]string{
+		"additions":             "includeCommitAdditions",
+		"authored_by_committer": "includeCommitAuthoredByCommitter",
+		"can_subscribe":         "includeCommitCanSubscribe",
+		"changed_files":         "includeCommitChangedFiles",
+		"commit_url":            "includeCommitCommitUrl",
+		"committed_via_web":     "includeCommitCommittedViaWeb",
+		"deletions":             "includeCommitDeletions",
+		"message_headline":      "includeCommitMessageHeadline",
+		"signature":             "includeCommitSignature",
+		"status":                "includeCommitStatus",
+		"subscription":          "includeCommitSubscription",
+		"tarball_url":           "includeCommitTarballUrl",
+		"tree_url":              "includeCommitTreeUrl",
+		"zipball_url":           "includeCommitZipballUrl",
+	}
+
+	// Tables returning the commit as a single column need all of its fields
+	all := slices.Contains(cols, "commit")
+	for key, value := range optionals {
+		(*m)[value] = githubv4.Boolean(all || slices.Contains(cols, key))
+	}
+
+	// The signature columns are all fetched with the signature
+	signatureCols := []string{"signature_verified", "signature_reason", "signature_type", "signer_login", "signature_email_matches_committer"}
+	if slices.ContainsFunc(signatureCols, func(col string) bool { return slices.Contains(cols, col) }) {
+		(*m)["includeCommitSignature"] = githubv4.Boolean(true)
+	}
+
+	// The associated pull requests are a connection of their own, which is
+	// only fetched when its column is selected
+	(*m)["includeCommitAssociatedPullRequests"] = githubv4.Boolean(slices.Contains(cols, "associated_pull_requests"))
+}
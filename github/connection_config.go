@@ -6,17 +6,86 @@ import (
 )
 
 type githubConfig struct {
-	Token   *string `cty:"token"`
-	BaseURL *string `cty:"base_url"`
+	Token            *string  `cty:"token"`
+	Tokens           []string `cty:"tokens"`
+	BaseURL          *string  `cty:"base_url"`
+	CredentialHelper *string  `cty:"credential_helper"`
+	AppID            *int64   `cty:"app_id"`
+	InstallationID   *int64   `cty:"installation_id"`
+	PrivateKey       *string  `cty:"private_key"`
+
+	SecondaryRateLimitMaxRetries *int `cty:"secondary_rate_limit_max_retries"`
+	SecondaryRateLimitMaxWait    *int `cty:"secondary_rate_limit_max_wait"`
+
+	MinRateLimitRemaining *int  `cty:"min_rate_limit_remaining"`
+	WaitForReset          *bool `cty:"wait_for_reset"`
+
+	DiskCacheDir *string `cty:"disk_cache_dir"`
+	DiskCacheTTL *int    `cty:"disk_cache_ttl"`
+
+	RepositoryConcurrency *int `cty:"repository_concurrency"`
+
+	Orgs         []string `cty:"orgs"`
+	Repos        []string `cty:"repos"`
+	ExcludeRepos []string `cty:"exclude_repos"`
 }
 
 var ConfigSchema = map[string]*schema.Attribute{
 	"token": {
 		Type: schema.TypeString,
 	},
+	"tokens": {
+		Type: schema.TypeList,
+		Elem: &schema.Attribute{Type: schema.TypeString},
+	},
 	"base_url": {
 		Type: schema.TypeString,
 	},
+	"credential_helper": {
+		Type: schema.TypeString,
+	},
+	"app_id": {
+		Type: schema.TypeInt,
+	},
+	"installation_id": {
+		Type: schema.TypeInt,
+	},
+	"private_key": {
+		Type: schema.TypeString,
+	},
+	"secondary_rate_limit_max_retries": {
+		Type: schema.TypeInt,
+	},
+	"secondary_rate_limit_max_wait": {
+		Type: schema.TypeInt,
+	},
+	"min_rate_limit_remaining": {
+		Type: schema.TypeInt,
+	},
+	"wait_for_reset": {
+		Type: schema.TypeBool,
+	},
+	"disk_cache_dir": {
+		Type: schema.TypeString,
+	},
+	"disk_cache_ttl": {
+		Type: schema.TypeInt,
+	},
+	"repository_concurrency": {
+		Type: schema.TypeInt,
+	},
+	"orgs": {
+		Type: schema.TypeList,
+		Elem: &schema.Attribute{Type: schema.TypeString},
+	},
+	"repos": {
+		Type: schema.TypeList,
+		Elem: &schema.Attribute{Type: schema.TypeString},
+	},
+	"exclude_repos": {
+		Type: schema.TypeList,
+		Elem: &schema.Attribute{Type: schema.TypeString},
+	},
 }
 
 func ConfigInstance() interface{} {
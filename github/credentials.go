@@ -0,0 +1,84 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// credentialCommandTimeout is how long the gh CLI or the credential helper may take to return a token
+const credentialCommandTimeout = 30 * time.Second
+
+// resolveFallbackToken returns a token for the host of the base URL when no token is set in the connection config or
+// the GITHUB_TOKEN environment variable. The token is taken from the GH_TOKEN environment variable, then the
+// credentials stored by the gh CLI, then the output of the credential helper command of the connection config.
+func resolveFallbackToken(config githubConfig, baseURL string) (string, error) {
+	if token := os.Getenv("GH_TOKEN"); token != "" {
+		return token, nil
+	}
+
+	host := githubHost(baseURL)
+
+	// The gh CLI is optional, so failing to get a token from it is not an error
+	if path, err := exec.LookPath("gh"); err == nil {
+		if token, err := runCredentialCommand(nil, path, "auth", "token", "--hostname", host); err == nil && token != "" {
+			return token, nil
+		}
+	}
+
+	if config.CredentialHelper != nil && *config.CredentialHelper != "" {
+		shell, flag := "sh", "-c"
+		if runtime.GOOS == "windows" {
+			shell, flag = "cmd", "/C"
+		}
+
+		// The host is passed to the helper so it can serve several hosts
+		env := append(os.Environ(), "GITHUB_HOST="+host)
+		token, err := runCredentialCommand(env, shell, flag, *config.CredentialHelper)
+		if err != nil {
+			return "", fmt.Errorf("credential_helper failed: %v", err)
+		}
+		if token == "" {
+			return "", fmt.Errorf("credential_helper did not return a token")
+		}
+		return token, nil
+	}
+
+	return "", nil
+}
+
+// runCredentialCommand runs the command with the given environment, or the plugin's environment if nil, and returns
+// its trimmed output
+func runCredentialCommand(env []string, name string, args ...string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), credentialCommandTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Env = env
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("%v: %s", err, msg)
+		}
+		return "", err
+	}
+
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// githubHost returns the host name the gh CLI stores the credentials of the base URL under
+func githubHost(baseURL string) string {
+	u, err := url.Parse(baseURL)
+	if baseURL == "" || err != nil || u.Host == "" || u.Host == "api.github.com" {
+		return "github.com"
+	}
+	return u.Host
+}
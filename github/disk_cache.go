@@ -0,0 +1,166 @@
+package github
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+const (
+	// defaultDiskCacheTTL is how long in seconds responses are served from the disk cache, unless set by
+	// disk_cache_ttl
+	defaultDiskCacheTTL = 3600
+	// diskCacheHeader is set on responses served from the disk cache
+	diskCacheHeader = "X-From-Disk-Cache"
+)
+
+// diskCacheExcludedTables are the tables of live data, whose responses must not be served from the disk cache
+var diskCacheExcludedTables = map[string]bool{
+	"github_rate_limit":         true,
+	"github_rate_limit_graphql": true,
+}
+
+// diskCache persists successful API responses to files in a directory and serves them until they are older than the
+// TTL, so that the cache survives restarts of the plugin. Both REST and GraphQL requests are cached, by connection and
+// by request, as the queries of the plugin only read data.
+type diskCache struct {
+	base       http.RoundTripper
+	dir        string
+	ttl        time.Duration
+	connection string
+	// credential is a hash of the credentials of the connection, so that responses fetched with other credentials
+	// are not served once the credentials are changed
+	credential string
+}
+
+func newDiskCache(config githubConfig, connection string, credential string, base http.RoundTripper) (*diskCache, error) {
+	dir, err := expandHomeDir(*config.DiskCacheDir)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	ttl := defaultDiskCacheTTL
+	if config.DiskCacheTTL != nil {
+		ttl = *config.DiskCacheTTL
+	}
+	return &diskCache{base: base, dir: dir, ttl: time.Duration(ttl) * time.Second, connection: connection, credential: credential}, nil
+}
+
+func (c *diskCache) RoundTrip(req *http.Request) (*http.Response, error) {
+	graphql := strings.HasSuffix(req.URL.Path, "/graphql")
+	if req.Method != http.MethodGet && !(req.Method == http.MethodPost && graphql) {
+		return c.base.RoundTrip(req)
+	}
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	hash := sha256.New()
+	for _, part := range []string{c.connection, c.credential, req.Method, req.URL.String(), req.Header.Get("Accept")} {
+		hash.Write([]byte(part))
+		hash.Write([]byte{0})
+	}
+	hash.Write(body)
+	path := filepath.Join(c.dir, hex.EncodeToString(hash.Sum(nil)))
+
+	if resp := c.read(req, path); resp != nil {
+		return resp, nil
+	}
+
+	resp, err := c.base.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	// GraphQL responses with errors may be incomplete
+	if graphql {
+		var result struct {
+			Errors []json.RawMessage `json:"errors"`
+		}
+		if json.Unmarshal(respBody, &result) != nil || len(result.Errors) > 0 {
+			return resp, nil
+		}
+	}
+
+	if err := c.write(resp, path); err != nil {
+		plugin.Logger(req.Context()).Warn("diskCache.RoundTrip", "write_error", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+	return resp, nil
+}
+
+// read returns the cached response of the file, or nil if there is none or it has expired
+func (c *diskCache) read(req *http.Request, path string) *http.Response {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil
+	}
+	if time.Since(info.ModTime()) > c.ttl {
+		os.Remove(path)
+		return nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(data)), req)
+	if err != nil {
+		return nil
+	}
+	resp.Header.Set(diskCacheHeader, "1")
+	return resp
+}
+
+// write saves the response to the file. It is written to a temporary file first so that concurrent reads never see
+// part of a response.
+func (c *diskCache) write(resp *http.Response, path string) error {
+	data, err := httputil.DumpResponse(resp, true)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(c.dir, ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
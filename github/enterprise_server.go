@@ -0,0 +1,245 @@
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// enterpriseAPIURLs returns the REST and GraphQL API URLs for the given base URL. The base URL of a GitHub Enterprise
+// Server instance is accepted with or without the /api/v3 or /api/graphql path of either API.
+func enterpriseAPIURLs(baseURL string) (*url.URL, *url.URL, error) {
+	u, err := url.Parse(strings.TrimSpace(baseURL))
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, nil, fmt.Errorf("github.base_url is invalid: %s", baseURL)
+	}
+
+	rest, graphql := *u, *u
+	if u.Host == "api.github.com" {
+		rest.Path = "/"
+		graphql.Path = "/graphql"
+		return &rest, &graphql, nil
+	}
+
+	path := strings.TrimSuffix(u.Path, "/")
+	for _, suffix := range []string{"/api/v3", "/api/graphql", "/api"} {
+		path = strings.TrimSuffix(path, suffix)
+	}
+	rest.Path = path + "/api/v3/"
+	graphql.Path = path + "/api/graphql"
+	return &rest, &graphql, nil
+}
+
+// maxUnsupportedFieldRetries is the number of times a GraphQL query is retried after removing the fields which are not
+// supported by the server
+const maxUnsupportedFieldRetries = 5
+
+// enterpriseServerTransport degrades GraphQL queries gracefully on older GitHub Enterprise Server versions, which do
+// not support all of the fields of the GitHub cloud schema. Queries are rejected as a whole if they select a field
+// which does not exist, so such fields are removed from the query and it is retried. The columns of removed fields are
+// returned empty.
+type enterpriseServerTransport struct {
+	base http.RoundTripper
+}
+
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+type graphQLErrorResponse struct {
+	Errors []struct {
+		Message    string `json:"message"`
+		Extensions struct {
+			Code      string `json:"code"`
+			FieldName string `json:"fieldName"`
+			TypeName  string `json:"typeName"`
+		} `json:"extensions"`
+		Locations []struct {
+			Line   int `json:"line"`
+			Column int `json:"column"`
+		} `json:"locations"`
+	} `json:"errors"`
+}
+
+func (t *enterpriseServerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body == nil || req.Method != http.MethodPost {
+		return t.base.RoundTrip(req)
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		retry := req.Clone(req.Context())
+		retry.Body = io.NopCloser(bytes.NewReader(body))
+		retry.ContentLength = int64(len(body))
+
+		resp, err := t.base.RoundTrip(retry)
+		if err != nil || resp.StatusCode != http.StatusOK || attempt == maxUnsupportedFieldRetries {
+			return resp, err
+		}
+
+		respBody, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+		rewritten, ok := removeUnsupportedFields(req, body, respBody)
+		if !ok {
+			return resp, nil
+		}
+		body = rewritten
+	}
+}
+
+// removeUnsupportedFields returns the request body without the fields reported as not existing in the response
+func removeUnsupportedFields(req *http.Request, body []byte, respBody []byte) ([]byte, bool) {
+	if !bytes.Contains(respBody, []byte("undefinedField")) {
+		return nil, false
+	}
+
+	var errResp graphQLErrorResponse
+	var gqlReq graphQLRequest
+	if json.Unmarshal(respBody, &errResp) != nil || json.Unmarshal(body, &gqlReq) != nil {
+		return nil, false
+	}
+
+	// The queries are built on a single line, so the column of each error is
+	// the offset of the field in the query
+	var offsets []int
+	for _, e := range errResp.Errors {
+		if e.Extensions.Code != "undefinedField" || len(e.Locations) == 0 || e.Locations[0].Line != 1 {
+			continue
+		}
+		plugin.Logger(req.Context()).Warn("enterpriseServerTransport", "unsupported_field", e.Extensions.FieldName, "type", e.Extensions.TypeName)
+		offsets = append(offsets, e.Locations[0].Column-1)
+	}
+	if len(offsets) == 0 {
+		return nil, false
+	}
+
+	// Fields are removed from the end of the query so the offsets of the
+	// remaining fields are unchanged
+	sort.Sort(sort.Reverse(sort.IntSlice(offsets)))
+	query := gqlReq.Query
+	for _, offset := range offsets {
+		if offset < 0 || offset >= len(query) {
+			return nil, false
+		}
+		query = removeGraphQLField(query, offset)
+	}
+	gqlReq.Query = removeUnusedGraphQLVariables(query, gqlReq.Variables)
+
+	rewritten, err := json.Marshal(gqlReq)
+	if err != nil {
+		return nil, false
+	}
+	return rewritten, true
+}
+
+// removeGraphQLField removes the field starting at the given offset along with its alias, arguments, directives and
+// selection set
+func removeGraphQLField(query string, offset int) string {
+	i := skipGraphQLName(query, offset)
+	i = skipGraphQLSpaces(query, i)
+	if i < len(query) && query[i] == ':' {
+		i = skipGraphQLName(query, skipGraphQLSpaces(query, i+1))
+		i = skipGraphQLSpaces(query, i)
+	}
+	i = skipGraphQLGroup(query, i, '(', ')')
+	for i = skipGraphQLSpaces(query, i); i < len(query) && query[i] == '@'; i = skipGraphQLSpaces(query, i) {
+		i = skipGraphQLName(query, i+1)
+		i = skipGraphQLGroup(query, skipGraphQLSpaces(query, i), '(', ')')
+	}
+	i = skipGraphQLGroup(query, i, '{', '}')
+
+	start := offset
+	if i < len(query) && query[i] == ',' {
+		i++
+	} else if start > 0 && query[start-1] == ',' {
+		start--
+	}
+	return query[:start] + query[i:]
+}
+
+func skipGraphQLName(query string, i int) int {
+	for i < len(query) && (query[i] == '_' || query[i] >= 'a' && query[i] <= 'z' || query[i] >= 'A' && query[i] <= 'Z' || query[i] >= '0' && query[i] <= '9') {
+		i++
+	}
+	return i
+}
+
+func skipGraphQLSpaces(query string, i int) int {
+	for i < len(query) && query[i] == ' ' {
+		i++
+	}
+	return i
+}
+
+// skipGraphQLGroup skips the group opened at the given offset, if any, up to and including its matching close
+func skipGraphQLGroup(query string, i int, open byte, close byte) int {
+	if i >= len(query) || query[i] != open {
+		return i
+	}
+	depth, inString := 0, false
+	for ; i < len(query); i++ {
+		switch {
+		case inString:
+			if query[i] == '\\' {
+				i++
+			} else if query[i] == '"' {
+				inString = false
+			}
+		case query[i] == '"':
+			inString = true
+		case query[i] == open:
+			depth++
+		case query[i] == close:
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return i
+}
+
+var graphQLVariableDefinitionRegexp = regexp.MustCompile(`\$(\w+):[^$)]*`)
+
+// removeUnusedGraphQLVariables removes the definitions of the variables which are no longer used once fields have been
+// removed, as the server rejects queries with unused variables
+func removeUnusedGraphQLVariables(query string, variables map[string]interface{}) string {
+	if !strings.HasPrefix(query, "query(") {
+		return query
+	}
+	end := skipGraphQLGroup(query, len("query"), '(', ')')
+	definitions, selection := query[len("query")+1:end-1], query[end:]
+
+	var kept []string
+	for _, definition := range graphQLVariableDefinitionRegexp.FindAllStringSubmatch(definitions, -1) {
+		if regexp.MustCompile(`\$` + definition[1] + `\b`).MatchString(selection) {
+			kept = append(kept, definition[0])
+		} else {
+			delete(variables, definition[1])
+		}
+	}
+
+	if len(kept) == 0 {
+		return "query" + selection
+	}
+	return "query(" + strings.Join(kept, "") + ")" + selection
+}
@@ -0,0 +1,146 @@
+package github
+
+import (
+	"bufio"
+	"bytes"
+	"container/list"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"strings"
+	"sync"
+)
+
+const (
+	// etagCacheMaxSize is the total size in bytes of the responses held by the ETag cache of a connection
+	etagCacheMaxSize = 64 * 1024 * 1024
+	// etagCacheMaxEntrySize is the size in bytes of the largest response which is cached
+	etagCacheMaxEntrySize = 2 * 1024 * 1024
+	// etagCacheHeader is set on responses served from the ETag cache
+	etagCacheHeader = "X-From-Etag-Cache"
+)
+
+// etagCache makes REST requests conditional on the ETag of the last response to the same request, and serves the
+// cached response when GitHub responds with 304 Not Modified. Conditional requests which are not modified do not count
+// against the rate limit, so data which rarely changes, such as organizations, teams and repositories, can be queried
+// repeatedly for free. Least recently used responses are evicted once the cache is full.
+type etagCache struct {
+	base http.RoundTripper
+
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type etagCacheEntry struct {
+	key  string
+	etag string
+	// response is the response as sent over the wire
+	response []byte
+}
+
+func newETagCache(base http.RoundTripper) *etagCache {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &etagCache{base: base, order: list.New(), entries: map[string]*list.Element{}}
+}
+
+func (c *etagCache) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet || strings.HasSuffix(req.URL.Path, "/graphql") || req.Header.Get("Range") != "" {
+		return c.base.RoundTrip(req)
+	}
+
+	// Responses differ by token and by requested media type
+	key := req.Header.Get("Authorization") + " " + req.Header.Get("Accept") + " " + req.URL.String()
+
+	entry := c.get(key)
+	if entry != nil {
+		req = req.Clone(req.Context())
+		req.Header.Set("If-None-Match", entry.etag)
+	}
+
+	resp, err := c.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && entry != nil {
+		cached, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(entry.response)), req)
+		if err != nil {
+			return resp, nil
+		}
+		resp.Body.Close()
+
+		// The rate limit headers of the 304 response are current
+		for name, values := range resp.Header {
+			if strings.HasPrefix(strings.ToLower(name), "x-ratelimit-") {
+				cached.Header[name] = values
+			}
+		}
+		cached.Header.Set(etagCacheHeader, "1")
+		return cached, nil
+	}
+
+	etag := resp.Header.Get("ETag")
+	if resp.StatusCode != http.StatusOK || etag == "" || resp.ContentLength > etagCacheMaxEntrySize {
+		return resp, nil
+	}
+
+	// Responses of unknown length are cached only if they fit in an entry
+	body, err := io.ReadAll(io.LimitReader(resp.Body, etagCacheMaxEntrySize+1))
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	if len(body) > etagCacheMaxEntrySize {
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+		return resp, nil
+	}
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	response, err := httputil.DumpResponse(resp, true)
+	if err != nil {
+		return resp, nil
+	}
+	c.put(&etagCacheEntry{key: key, etag: etag, response: response})
+
+	return resp, nil
+}
+
+func (c *etagCache) get(key string) *etagCacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+	c.order.MoveToFront(element)
+	return element.Value.(*etagCacheEntry)
+}
+
+func (c *etagCache) put(entry *etagCacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[entry.key]; ok {
+		c.size -= len(element.Value.(*etagCacheEntry).response)
+		c.order.Remove(element)
+	}
+	c.entries[entry.key] = c.order.PushFront(entry)
+	c.size += len(entry.response)
+
+	for c.size > etagCacheMaxSize {
+		oldest := c.order.Back()
+		evicted := oldest.Value.(*etagCacheEntry)
+		c.order.Remove(oldest)
+		delete(c.entries, evicted.key)
+		c.size -= len(evicted.response)
+	}
+}
@@ -14,6 +14,8 @@ func extractIssueFromHydrateItem(h *plugin.HydrateData) (models.Issue, error) {
 		return issue, nil
 	} else if searchResult, ok := h.Item.(models.SearchIssueResult); ok {
 		return searchResult.Node.Issue, nil
+	} else if searchRow, ok := h.Item.(searchIssueRow); ok {
+		return searchRow.Node.Issue, nil
 	} else {
 		return models.Issue{}, fmt.Errorf("unable to parse hydrate item %v as an Issue", h.Item)
 	}
@@ -33,10 +35,15 @@ func appendIssueColumnIncludes(m *map[string]interface{}, cols []string) {
 		slices.Contains(cols, "user_did_author") ||
 		slices.Contains(cols, "user_subscription"))
 	(*m)["includeIssueAssigneeCount"] = githubv4.Boolean(slices.Contains(cols, "assignees_total_count"))
+	(*m)["includeIssueAssignees"] = githubv4.Boolean(slices.Contains(cols, "assignee_login"))
 	(*m)["includeIssueCommentCount"] = githubv4.Boolean(slices.Contains(cols, "comments_total_count"))
 	(*m)["includeIssueLabels"] = githubv4.Boolean(slices.Contains(cols, "labels") ||
+		slices.Contains(cols, "label") ||
 		slices.Contains(cols, "labels_src") ||
 		slices.Contains(cols, "labels_total_count"))
+	(*m)["includeIssueReactions"] = githubv4.Boolean(slices.Contains(cols, "reactions") || slices.Contains(cols, "reactions_total_count"))
+	(*m)["includeIssueClosedBy"] = githubv4.Boolean(slices.Contains(cols, "closed_by") || slices.Contains(cols, "closed_by_login"))
+	(*m)["includeIssueClosingPullRequests"] = githubv4.Boolean(slices.Contains(cols, "closing_pull_requests"))
 }
 
 func issueHydrateAuthor(_ context.Context, _ *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
@@ -55,6 +62,29 @@ func issueHydrateAuthorLogin(_ context.Context, _ *plugin.QueryData, h *plugin.H
 	return issue.Author.Login, nil
 }
 
+// issueHydrateClosedBy returns the actor of the latest closed event of the issue
+func issueHydrateClosedBy(_ context.Context, _ *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	issue, err := extractIssueFromHydrateItem(h)
+	if err != nil {
+		return nil, err
+	}
+	if len(issue.ClosedEvents.Nodes) == 0 {
+		return nil, nil
+	}
+	return issue.ClosedEvents.Nodes[len(issue.ClosedEvents.Nodes)-1].ClosedEvent.Actor, nil
+}
+
+func issueHydrateClosedByLogin(_ context.Context, _ *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	issue, err := extractIssueFromHydrateItem(h)
+	if err != nil {
+		return nil, err
+	}
+	if len(issue.ClosedEvents.Nodes) == 0 {
+		return nil, nil
+	}
+	return issue.ClosedEvents.Nodes[len(issue.ClosedEvents.Nodes)-1].ClosedEvent.Actor.Login, nil
+}
+
 func issueHydrateBody(_ context.Context, _ *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	issue, err := extractIssueFromHydrateItem(h)
 	if err != nil {
@@ -216,6 +246,14 @@ func appendPullRequestColumnIncludes(m *map[string]interface{}, cols []string) {
 	(*m)["includePRLabels"] = githubv4.Boolean(slices.Contains(cols, "labels") ||
 		slices.Contains(cols, "labels_src") ||
 		slices.Contains(cols, "labels_total_count"))
+	(*m)["includePRMergeStateStatus"] = githubv4.Boolean(slices.Contains(cols, "merge_state_status"))
+	(*m)["includePRMergeQueueEntry"] = githubv4.Boolean(slices.Contains(cols, "merge_queue_entry") ||
+		slices.Contains(cols, "merge_queue_state") ||
+		slices.Contains(cols, "merge_queue_position"))
+	(*m)["includePRAutoMerge"] = githubv4.Boolean(slices.Contains(cols, "auto_merge") ||
+		slices.Contains(cols, "auto_merge_method"))
+	(*m)["includePRRequiredApprovals"] = githubv4.Boolean(slices.Contains(cols, "required_approving_review_count") ||
+		slices.Contains(cols, "approving_reviews_remaining"))
 }
 
 func prHydrateAuthor(_ context.Context, _ *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
@@ -282,6 +320,93 @@ func prHydrateMergeCommit(_ context.Context, _ *plugin.QueryData, h *plugin.Hydr
 	return pr.MergeCommit, nil
 }
 
+func prHydrateMergeStateStatus(_ context.Context, _ *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	pr, err := extractPullRequestFromHydrateItem(h)
+	if err != nil {
+		return nil, err
+	}
+	return pr.MergeStateStatus, nil
+}
+
+func prHydrateMergeQueueEntry(_ context.Context, _ *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	pr, err := extractPullRequestFromHydrateItem(h)
+	if err != nil {
+		return nil, err
+	}
+	return pr.MergeQueueEntry, nil
+}
+
+func prHydrateMergeQueueState(_ context.Context, _ *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	pr, err := extractPullRequestFromHydrateItem(h)
+	if err != nil {
+		return nil, err
+	}
+	if pr.MergeQueueEntry == nil {
+		return nil, nil
+	}
+	return pr.MergeQueueEntry.State, nil
+}
+
+func prHydrateMergeQueuePosition(_ context.Context, _ *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	pr, err := extractPullRequestFromHydrateItem(h)
+	if err != nil {
+		return nil, err
+	}
+	if pr.MergeQueueEntry == nil {
+		return nil, nil
+	}
+	return pr.MergeQueueEntry.Position, nil
+}
+
+func prHydrateAutoMerge(_ context.Context, _ *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	pr, err := extractPullRequestFromHydrateItem(h)
+	if err != nil {
+		return nil, err
+	}
+	return pr.AutoMergeRequest, nil
+}
+
+func prHydrateAutoMergeMethod(_ context.Context, _ *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	pr, err := extractPullRequestFromHydrateItem(h)
+	if err != nil {
+		return nil, err
+	}
+	if pr.AutoMergeRequest == nil {
+		return nil, nil
+	}
+	return pr.AutoMergeRequest.MergeMethod, nil
+}
+
+func prHydrateRequiredApprovingReviewCount(_ context.Context, _ *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	pr, err := extractPullRequestFromHydrateItem(h)
+	if err != nil {
+		return nil, err
+	}
+	if pr.BaseRefRule == nil || pr.BaseRefRule.BranchProtectionRule == nil {
+		return nil, nil
+	}
+	return pr.BaseRefRule.BranchProtectionRule.RequiredApprovingReviewCount, nil
+}
+
+// prHydrateApprovingReviewsRemaining returns how many more approving reviews the base branch protection rule
+// requires, counting only the latest approving review of each reviewer with write access
+func prHydrateApprovingReviewsRemaining(_ context.Context, _ *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	pr, err := extractPullRequestFromHydrateItem(h)
+	if err != nil {
+		return nil, err
+	}
+	if pr.BaseRefRule == nil || pr.BaseRefRule.BranchProtectionRule == nil {
+		return nil, nil
+	}
+	approvals := 0
+	for _, review := range pr.LatestOpinionatedReviews.Nodes {
+		if review.State == "APPROVED" {
+			approvals++
+		}
+	}
+	return max(pr.BaseRefRule.BranchProtectionRule.RequiredApprovingReviewCount-approvals, 0), nil
+}
+
 func prHydrateSuggested(_ context.Context, _ *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	pr, err := extractPullRequestFromHydrateItem(h)
 	if err != nil {
@@ -457,3 +582,19 @@ func prHydrateSubscription(_ context.Context, _ *plugin.QueryData, h *plugin.Hyd
 	}
 	return pr.Subscription, nil
 }
+
+func issueHydrateRawJSON(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	issue, err := extractIssueFromHydrateItem(h)
+	if err != nil {
+		return nil, err
+	}
+	return getRawJSON(ctx, d, fmt.Sprintf("repos/%s/issues/%d", issue.Repo.NameWithOwner, issue.Number))
+}
+
+func prHydrateRawJSON(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	pr, err := extractPullRequestFromHydrateItem(h)
+	if err != nil {
+		return nil, err
+	}
+	return getRawJSON(ctx, d, fmt.Sprintf("repos/%s/pulls/%d", pr.Repo.NameWithOwner, pr.Number))
+}
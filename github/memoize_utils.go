@@ -0,0 +1,138 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v55/github"
+	"github.com/turbot/steampipe-plugin-github/github/models"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+const (
+	// memoizedLookupMaxTTL is the longest the memoized repository and organization lookups are kept for, their
+	// freshness is set by the query cache settings through the cache key
+	memoizedLookupMaxTTL = time.Hour
+	// memoizedLookupUncachedTTL is how long the lookups are shared for when the query cache is disabled, which is long
+	// enough for the tables joined by a query to share them
+	memoizedLookupUncachedTTL = 10 * time.Second
+)
+
+// The lookups are memoized with the SDK, which caches the results in the connection cache and makes concurrent
+// lookups of the same key wait for the first one, so the tables joined by a query fetch each repository or
+// organization once. The key of each lookup is passed to the memoized function as the hydrate item.
+//
+// The memoized functions are called directly rather than used as column hydrate functions, as the SDK identifies
+// column hydrate functions by name.
+var (
+	getRepositoryV3Memoized   = plugin.HydrateFunc(getRepositoryV3Uncached).Memoize(memoizedLookupCacheKey("github_repository_v3"))
+	getRepositoryMemoized     = plugin.HydrateFunc(getRepositoryUncached).Memoize(memoizedLookupCacheKey("github_repository_v4"))
+	getOrganizationV3Memoized = plugin.HydrateFunc(getOrganizationV3Uncached).Memoize(memoizedLookupCacheKey("github_organization_v3"))
+
+	listOwnerRepositoryFullNamesMemoized = plugin.HydrateFunc(listOwnerRepositoryFullNamesUncached).Memoize(memoizedLookupCacheKey("github_owner_repository_full_names"))
+)
+
+// memoizedLookupCacheKey keys the lookups by the time window of the query cache TTL, so that a lookup is not served
+// for longer than the query cache would serve the rows, or only for the duration of a query if the cache is disabled
+func memoizedLookupCacheKey(prefix string) plugin.MemoizeOption {
+	return func(config *plugin.MemoizeConfiguration) {
+		config.GetCacheKeyFunc = func(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+			window := memoizedLookupWindow(d)
+			return fmt.Sprintf("%s|%s|%d", prefix, h.Item.(string), time.Now().UnixNano()/int64(window)), nil
+		}
+		config.Ttl = memoizedLookupMaxTTL
+	}
+}
+
+func memoizedLookupWindow(d *plugin.QueryData) time.Duration {
+	if d.QueryContext == nil || !d.QueryContext.CacheEnabled || d.QueryContext.CacheTTL <= 0 {
+		return memoizedLookupUncachedTTL
+	}
+	return min(time.Duration(d.QueryContext.CacheTTL)*time.Second, memoizedLookupMaxTTL)
+}
+
+// repositoryV3 is the REST representation of a repository, along with the object as it was returned for the raw_json
+// column
+type repositoryV3 struct {
+	Repository *github.Repository
+	Raw        json.RawMessage
+}
+
+// getRepositoryV3 returns the REST representation of the repository
+func getRepositoryV3(ctx context.Context, d *plugin.QueryData, owner string, repo string) (*github.Repository, error) {
+	result, err := getRepositoryV3Memoized(ctx, d, &plugin.HydrateData{Item: owner + "/" + repo})
+	if err != nil {
+		return nil, err
+	}
+	return result.(repositoryV3).Repository, nil
+}
+
+// getRepositoryV3RawJSON returns the REST representation of the repository as it was returned
+func getRepositoryV3RawJSON(ctx context.Context, d *plugin.QueryData, owner string, repo string) (json.RawMessage, error) {
+	result, err := getRepositoryV3Memoized(ctx, d, &plugin.HydrateData{Item: owner + "/" + repo})
+	if err != nil {
+		return nil, err
+	}
+	return result.(repositoryV3).Raw, nil
+}
+
+func getRepositoryV3Uncached(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	owner, repo := parseRepoFullName(h.Item.(string))
+	raw, err := getRawJSON(ctx, d, fmt.Sprintf("repos/%s/%s", owner, repo))
+	if err != nil {
+		return nil, err
+	}
+
+	var repository github.Repository
+	if err := json.Unmarshal(raw, &repository); err != nil {
+		return nil, err
+	}
+	return repositoryV3{Repository: &repository, Raw: raw}, nil
+}
+
+// getRepository returns the GraphQL representation of the repository with the fields of the selected columns. The
+// lookups of a scan of several repositories are batched into a single query.
+func getRepository(ctx context.Context, d *plugin.QueryData, fullName string) (models.Repository, error) {
+	// The fields of the repository depend on the selected columns
+	cols := slices.Clone(d.QueryContext.Columns)
+	slices.Sort(cols)
+	result, err := getRepositoryMemoized(ctx, d, &plugin.HydrateData{Item: fullName + "|" + strings.Join(cols, ",")})
+	if err != nil {
+		return models.Repository{}, err
+	}
+	return result.(models.Repository), nil
+}
+
+func getRepositoryUncached(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	fullName, _, _ := strings.Cut(h.Item.(string), "|")
+	return getRepositoryBatched(ctx, d, fullName)
+}
+
+// getOrganizationV3 returns the REST representation of the organization
+func getOrganizationV3(ctx context.Context, d *plugin.QueryData, login string) (*github.Organization, error) {
+	result, err := getOrganizationV3Memoized(ctx, d, &plugin.HydrateData{Item: login})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*github.Organization), nil
+}
+
+func getOrganizationV3Uncached(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+	organization, _, err := client.Organizations.Get(ctx, h.Item.(string))
+	return organization, err
+}
+
+// listOwnerRepositoryFullNames returns the full names of the repositories of the user or organization, which expand the
+// repository patterns of queries and of the connection config
+func listOwnerRepositoryFullNames(ctx context.Context, d *plugin.QueryData, owner string) ([]string, error) {
+	result, err := listOwnerRepositoryFullNamesMemoized(ctx, d, &plugin.HydrateData{Item: owner})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]string), nil
+}
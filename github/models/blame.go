@@ -0,0 +1,8 @@
+package models
+
+type BlameRange struct {
+	StartingLine int         `json:"starting_line"`
+	EndingLine   int         `json:"ending_line"`
+	Age          int         `json:"age"`
+	Commit       BasicCommit `json:"commit"`
+}
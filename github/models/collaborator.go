@@ -0,0 +1,29 @@
+package models
+
+import "github.com/shurcooL/githubv4"
+
+type RepositoryCollaborator struct {
+	Permission        githubv4.RepositoryPermission `json:"permission"`
+	PermissionSources []PermissionSource            `json:"permission_sources"`
+	Node              BasicUser                     `json:"node"`
+}
+
+// PermissionSource is a level of permission and the source from which it was granted, either
+// directly on the repository, via a team or via the organization's base permission.
+type PermissionSource struct {
+	Permission string `json:"permission"`
+	RoleName   string `json:"role_name"`
+	Source     struct {
+		Type         string `graphql:"type: __typename" json:"type"`
+		Organization struct {
+			Login string `json:"login"`
+		} `graphql:"... on Organization" json:"organization"`
+		Repository struct {
+			NameWithOwner string `json:"name_with_owner"`
+		} `graphql:"... on Repository" json:"repository"`
+		Team struct {
+			Name string `json:"name"`
+			Slug string `json:"slug"`
+		} `graphql:"... on Team" json:"team"`
+	} `json:"source"`
+}
@@ -15,22 +15,26 @@ type BasicCommit struct {
 // Commit returns the full detail of a Commit
 type Commit struct {
 	BasicCommit
-	Additions           int          `json:"additions"`
-	AuthoredByCommitter bool         `json:"authored_by_committer"`
-	ChangedFiles        int          `graphql:"changedFiles: changedFilesIfAvailable" json:"changed_files"`
-	CommittedViaWeb     bool         `json:"committed_via_web"`
-	CommitUrl           string       `json:"commit_url"`
-	Deletions           int          `json:"deletions"`
-	Signature           Signature    `json:"signature"`
-	TarballUrl          string       `json:"tarball_url"`
-	TreeUrl             string       `json:"tree_url"`
-	CanSubscribe        bool         `graphql:"canSubscribe: viewerCanSubscribe" json:"can_subscribe"`
-	Subscription        string       `graphql:"subscription: viewerSubscription" json:"subscription"`
-	ZipballUrl          string       `json:"zipball_url"`
-	MessageHeadline     string       `json:"message_headline"`
-	Status              CommitStatus `json:"status"`
+	Additions           int          `graphql:"additions @include(if:$includeCommitAdditions)" json:"additions"`
+	AuthoredByCommitter bool         `graphql:"authoredByCommitter @include(if:$includeCommitAuthoredByCommitter)" json:"authored_by_committer"`
+	ChangedFiles        int          `graphql:"changedFiles: changedFilesIfAvailable @include(if:$includeCommitChangedFiles)" json:"changed_files"`
+	CommittedViaWeb     bool         `graphql:"committedViaWeb @include(if:$includeCommitCommittedViaWeb)" json:"committed_via_web"`
+	CommitUrl           string       `graphql:"commitUrl @include(if:$includeCommitCommitUrl)" json:"commit_url"`
+	Deletions           int          `graphql:"deletions @include(if:$includeCommitDeletions)" json:"deletions"`
+	Signature           Signature    `graphql:"signature @include(if:$includeCommitSignature)" json:"signature"`
+	TarballUrl          string       `graphql:"tarballUrl @include(if:$includeCommitTarballUrl)" json:"tarball_url"`
+	TreeUrl             string       `graphql:"treeUrl @include(if:$includeCommitTreeUrl)" json:"tree_url"`
+	CanSubscribe        bool         `graphql:"canSubscribe: viewerCanSubscribe @include(if:$includeCommitCanSubscribe)" json:"can_subscribe"`
+	Subscription        string       `graphql:"subscription: viewerSubscription @include(if:$includeCommitSubscription)" json:"subscription"`
+	ZipballUrl          string       `graphql:"zipballUrl @include(if:$includeCommitZipballUrl)" json:"zipball_url"`
+	MessageHeadline     string       `graphql:"messageHeadline @include(if:$includeCommitMessageHeadline)" json:"message_headline"`
+	Status              CommitStatus `graphql:"status @include(if:$includeCommitStatus)" json:"status"`
 	NodeId              string       `graphql:"nodeId:id" json:"node_id"`
-	// AssociatedPullRequests [Pageable]
+	// AssociatedPullRequests are the first 10 pull requests which contain the commit, e.g. the ones it was merged by
+	AssociatedPullRequests struct {
+		TotalCount int                    `json:"total_count"`
+		Nodes      []PullRequestReference `json:"nodes"`
+	} `graphql:"associatedPullRequests(first: 10) @include(if:$includeCommitAssociatedPullRequests)" json:"associated_pull_requests"`
 	// Authors [Pageable]
 	// Blame [n-level nesting for an array, requires a path, etc]
 	// CheckSuites [Pageable]
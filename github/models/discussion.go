@@ -0,0 +1,29 @@
+package models
+
+type Discussion struct {
+	Id             int          `graphql:"id: databaseId" json:"id,omitempty"`
+	NodeId         string       `graphql:"nodeId: id" json:"node_id,omitempty"`
+	Number         int          `json:"number"`
+	Title          string       `json:"title"`
+	Body           string       `json:"body"`
+	Url            string       `json:"url"`
+	CreatedAt      NullableTime `json:"created_at"`
+	UpdatedAt      NullableTime `json:"updated_at"`
+	Closed         bool         `json:"closed"`
+	ClosedAt       NullableTime `json:"closed_at"`
+	Locked         bool         `json:"locked"`
+	IsAnswered     bool         `json:"is_answered"`
+	AnswerChosenAt NullableTime `json:"answer_chosen_at"`
+	UpvoteCount    int          `json:"upvote_count"`
+	Author         Actor        `json:"author"`
+	Category       struct {
+		Name         string `json:"name"`
+		IsAnswerable bool   `json:"is_answerable"`
+	} `json:"category"`
+	Repository struct {
+		NameWithOwner string `json:"name_with_owner"`
+	} `json:"repository"`
+	Comments struct {
+		TotalCount int `json:"total_count"`
+	} `json:"comments"`
+}
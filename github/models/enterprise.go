@@ -0,0 +1,29 @@
+package models
+
+type EnterpriseMember struct {
+	Type                  string `graphql:"type: __typename" json:"type"`
+	EnterpriseUserAccount struct {
+		NodeId        string       `graphql:"nodeId: id" json:"node_id"`
+		Login         string       `json:"login"`
+		Name          string       `json:"name"`
+		CreatedAt     NullableTime `json:"created_at"`
+		UpdatedAt     NullableTime `json:"updated_at"`
+		Url           string       `json:"url"`
+		Organizations struct {
+			Edges []struct {
+				Role string `json:"role"`
+				Node struct {
+					Login string `json:"login"`
+				} `json:"node"`
+			} `json:"edges"`
+		} `graphql:"organizations(first: 100) @include(if:$includeOrganizations)" json:"organizations"`
+	} `graphql:"... on EnterpriseUserAccount" json:"enterprise_user_account"`
+	User struct {
+		BasicUser
+		Organizations struct {
+			Nodes []struct {
+				Login string `json:"login"`
+			} `json:"nodes"`
+		} `graphql:"organizations(first: 100) @include(if:$includeOrganizations)" json:"organizations"`
+	} `graphql:"... on User" json:"user"`
+}
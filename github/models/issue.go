@@ -39,13 +39,30 @@ type Issue struct {
 	UserSubscription        githubv4.SubscriptionState           `graphql:"userSubscription: viewerSubscription @include(if:$includeIssueViewer)" json:"user_subscription"`
 	Comments                Count                                `graphql:"comments @include(if:$includeIssueCommentCount)" json:"comments"`
 	Assignees               Count                                `graphql:"assignees @include(if:$includeIssueAssigneeCount)" json:"assignees"`
-	Labels                  struct {
+	AssigneeLogins          struct {
+		Nodes []struct {
+			Login string `json:"login"`
+		} `json:"nodes"`
+	} `graphql:"assigneeLogins: assignees(first: 10) @include(if:$includeIssueAssignees)" json:"assignee_logins"`
+	Labels struct {
 		TotalCount int
 		Nodes      []Label
 	} `graphql:"labels(first: 100) @include(if:$includeIssueLabels)" json:"labels"`
 	Repo struct {
 		NameWithOwner string `json:"name_with_owner"`
 	} `graphql:"repo: repository" json:"repo"`
+	ReactionGroups []ReactionGroup `graphql:"reactionGroups @include(if:$includeIssueReactions)" json:"reaction_groups"`
+	ClosedEvents   struct {
+		Nodes []struct {
+			ClosedEvent struct {
+				Actor Actor `json:"actor"`
+			} `graphql:"... on ClosedEvent"`
+		}
+	} `graphql:"closedEvents: timelineItems(itemTypes: [CLOSED_EVENT], last: 1) @include(if:$includeIssueClosedBy)" json:"closed_events"`
+	ClosedByPullRequestsReferences struct {
+		TotalCount int                    `json:"total_count"`
+		Nodes      []PullRequestReference `json:"nodes"`
+	} `graphql:"closedByPullRequestsReferences(first: 10, includeClosedPrs: true) @include(if:$includeIssueClosingPullRequests)" json:"closed_by_pull_requests_references"`
 
 	// Assignees [pageable]
 	// LinkedBranches [pageable]
@@ -55,7 +72,6 @@ type Issue struct {
 	// ProjectV2 [find by number]
 	// ProjectsV2 [pageable]
 	// Reactions [pageable]
-	// TimelineItems [pageable]
 	// TrackedInIssues [pageable]
 	// TrackedIssues [pageable]
 	// UserContentEdits [pageable]
@@ -94,4 +110,8 @@ type IssueComment struct {
 	CanUpdate           bool                                 `graphql:"canUpdate: viewerCanUpdate" json:"can_update"`
 	CannotUpdateReasons []githubv4.CommentCannotUpdateReason `graphql:"cannotUpdateReasons: viewerCannotUpdateReasons" json:"cannot_update_reasons"`
 	DidAuthor           bool                                 `graphql:"didAuthor: viewerDidAuthor" json:"did_author"`
+	ReactionGroups      []ReactionGroup                      `json:"reaction_groups"`
+	Repository          struct {
+		NameWithOwner string `json:"name_with_owner"`
+	} `json:"repository"`
 }
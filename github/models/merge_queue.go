@@ -0,0 +1,25 @@
+package models
+
+type MergeQueueEntry struct {
+	NodeId               string       `graphql:"nodeId: id" json:"node_id"`
+	Position             int          `json:"position"`
+	State                string       `json:"state"`
+	EnqueuedAt           NullableTime `json:"enqueued_at"`
+	EstimatedTimeToMerge int          `json:"estimated_time_to_merge"`
+	Jump                 bool         `json:"jump"`
+	Solo                 bool         `json:"solo"`
+	Enqueuer             Actor        `json:"enqueuer"`
+	BaseCommit           struct {
+		Oid string `json:"oid"`
+	} `json:"base_commit"`
+	HeadCommit struct {
+		Oid string `json:"oid"`
+	} `json:"head_commit"`
+	PullRequest struct {
+		Number      int    `json:"number"`
+		Title       string `json:"title"`
+		Url         string `json:"url"`
+		BaseRefName string `json:"base_ref_name"`
+		HeadRefName string `json:"head_ref_name"`
+	} `json:"pull_request"`
+}
@@ -169,3 +169,12 @@ type emailMetadata struct {
 	Type    string `json:"type"`
 	Value   string `json:"value"`
 }
+
+type IpAllowListEntry struct {
+	NodeId         string       `graphql:"nodeId: id" json:"node_id"`
+	AllowListValue string       `json:"allow_list_value"`
+	Name           string       `json:"name"`
+	IsActive       bool         `json:"is_active"`
+	CreatedAt      NullableTime `json:"created_at"`
+	UpdatedAt      NullableTime `json:"updated_at"`
+}
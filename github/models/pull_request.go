@@ -81,6 +81,21 @@ type PullRequest struct {
 		Nodes      []Label
 	} `graphql:"labels(first: 100) @include(if:$includePRLabels)" json:"labels"`
 
+	// Merge readiness
+	MergeStateStatus string                      `graphql:"mergeStateStatus @include(if:$includePRMergeStateStatus)" json:"merge_state_status"`
+	MergeQueueEntry  *PullRequestMergeQueueEntry `graphql:"mergeQueueEntry @include(if:$includePRMergeQueueEntry)" json:"merge_queue_entry,omitempty"`
+	AutoMergeRequest *PullRequestAutoMerge       `graphql:"autoMergeRequest @include(if:$includePRAutoMerge)" json:"auto_merge_request,omitempty"`
+	BaseRefRule      *struct {
+		BranchProtectionRule *struct {
+			RequiredApprovingReviewCount int `json:"required_approving_review_count"`
+		} `json:"branch_protection_rule"`
+	} `graphql:"baseRefRule: baseRef @include(if:$includePRRequiredApprovals)" json:"base_ref_rule,omitempty"`
+	LatestOpinionatedReviews struct {
+		Nodes []struct {
+			State string `json:"state"`
+		} `json:"nodes"`
+	} `graphql:"latestOpinionatedReviews(first: 100, writersOnly: true) @include(if:$includePRRequiredApprovals)" json:"latest_opinionated_reviews"`
+
 	// Assignees [pageable]
 	// ClosingIssueReferences [pageable]
 	// Commits [pageable]
@@ -99,6 +114,25 @@ type PullRequest struct {
 	// TimelineItems [pageable]
 }
 
+type PullRequestMergeQueueEntry struct {
+	State                string       `json:"state"`
+	Position             int          `json:"position"`
+	EnqueuedAt           NullableTime `json:"enqueued_at"`
+	EstimatedTimeToMerge int          `json:"estimated_time_to_merge"`
+	Solo                 bool         `json:"solo"`
+	Jump                 bool         `json:"jump"`
+	Enqueuer             Actor        `json:"enqueuer"`
+}
+
+type PullRequestAutoMerge struct {
+	MergeMethod    githubv4.PullRequestMergeMethod `json:"merge_method"`
+	EnabledAt      NullableTime                    `json:"enabled_at"`
+	EnabledBy      Actor                           `json:"enabled_by"`
+	CommitHeadline string                          `json:"commit_headline"`
+	CommitBody     string                          `json:"commit_body"`
+	AuthorEmail    string                          `json:"author_email"`
+}
+
 type PullRequestReview struct {
 	Id                        int                               `graphql:"id: databaseId" json:"id"`
 	NodeId                    string                            `graphql:"nodeId: id" json:"node_id"`
@@ -111,6 +145,48 @@ type PullRequestReview struct {
 	SubmittedAt               NullableTime                      `json:"submitted_at"`
 }
 
+type PullRequestReviewComment struct {
+	Id                int                               `graphql:"id: databaseId" json:"id"`
+	NodeId            string                            `graphql:"nodeId: id" json:"node_id"`
+	Author            Actor                             `json:"author"`
+	AuthorAssociation githubv4.CommentAuthorAssociation `json:"author_association"`
+	Body              string                            `json:"body"`
+	BodyText          string                            `json:"body_text"`
+	CreatedAt         NullableTime                      `json:"created_at"`
+	UpdatedAt         NullableTime                      `json:"updated_at"`
+	Url               string                            `json:"url"`
+	Path              string                            `json:"path"`
+	DiffHunk          string                            `json:"diff_hunk"`
+	Outdated          bool                              `json:"outdated"`
+	Commit            struct {
+		Oid string `json:"oid"`
+	} `json:"commit"`
+	PullRequestReview struct {
+		Id int `graphql:"id: databaseId" json:"id"`
+	} `json:"pull_request_review"`
+	ReplyTo struct {
+		Id int `graphql:"id: databaseId" json:"id"`
+	} `json:"reply_to"`
+}
+
+type PullRequestReviewThread struct {
+	NodeId        string            `graphql:"nodeId: id" json:"node_id"`
+	IsResolved    bool              `json:"is_resolved"`
+	IsOutdated    bool              `json:"is_outdated"`
+	IsCollapsed   bool              `json:"is_collapsed"`
+	Path          string            `json:"path"`
+	Line          int               `json:"line"`
+	OriginalLine  int               `json:"original_line"`
+	StartLine     int               `json:"start_line"`
+	DiffSide      githubv4.DiffSide `json:"diff_side"`
+	StartDiffSide githubv4.DiffSide `json:"start_diff_side"`
+	ResolvedBy    Actor             `json:"resolved_by"`
+	Comments      struct {
+		PageInfo PageInfo
+		Nodes    []PullRequestReviewComment
+	} `graphql:"comments(first: 100)" json:"comments"`
+}
+
 type SuggestedReviewer struct {
 	IsAuthor    bool      `json:"is_author"`
 	IsCommenter bool      `json:"is_commenter"`
@@ -121,3 +197,15 @@ type PullRequestTemplate struct {
 	Filename string `json:"filename"`
 	Body     string `json:"body"`
 }
+
+// PullRequestReference identifies a pull request referenced by another object, e.g. a commit or an issue
+type PullRequestReference struct {
+	Number     int          `json:"number"`
+	Title      string       `json:"title"`
+	Url        string       `json:"url"`
+	State      string       `json:"state"`
+	MergedAt   NullableTime `json:"merged_at"`
+	Repository struct {
+		NameWithOwner string `json:"name_with_owner"`
+	} `json:"repository"`
+}
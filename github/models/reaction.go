@@ -0,0 +1,18 @@
+package models
+
+import "github.com/shurcooL/githubv4"
+
+type Reaction struct {
+	NodeId     string                   `graphql:"nodeId: id" json:"node_id"`
+	DatabaseId int                      `json:"database_id"`
+	Content    githubv4.ReactionContent `json:"content"`
+	CreatedAt  NullableTime             `json:"created_at"`
+	User       BasicUser                `json:"user"`
+}
+
+type ReactionGroup struct {
+	Content  githubv4.ReactionContent `json:"content"`
+	Reactors struct {
+		TotalCount int `json:"total_count"`
+	} `json:"reactors"`
+}
@@ -146,3 +146,30 @@ type RepositoryFundingLinks struct {
 	Url      string                   `json:"url"`
 	Platform githubv4.FundingPlatform `json:"platform"`
 }
+
+type RepositoryTopic struct {
+	NodeId string `graphql:"nodeId: id" json:"node_id"`
+	Url    string `json:"url"`
+	Topic  struct {
+		Name           string `json:"name"`
+		StargazerCount int    `json:"stargazer_count"`
+	} `json:"topic"`
+}
+
+type RepositoryFork struct {
+	basicIdentifiers
+	NameWithOwner string `json:"name_with_owner"`
+	Owner         struct {
+		Login string `json:"login"`
+	} `json:"owner"`
+	CreatedAt        NullableTime                  `json:"created_at"`
+	PushedAt         NullableTime                  `json:"pushed_at"`
+	UpdatedAt        NullableTime                  `json:"updated_at"`
+	StargazerCount   int                           `json:"stargazer_count"`
+	ForkCount        int                           `json:"fork_count"`
+	IsArchived       bool                          `json:"is_archived"`
+	IsPrivate        bool                          `json:"is_private"`
+	Visibility       githubv4.RepositoryVisibility `json:"visibility"`
+	Url              string                        `json:"url"`
+	DefaultBranchRef BasicRef                      `json:"default_branch_ref"`
+}
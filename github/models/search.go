@@ -32,3 +32,10 @@ type SearchPullRequestResult struct {
 		PullRequest `graphql:"... on PullRequest"`
 	}
 }
+
+type SearchDiscussionResult struct {
+	TextMatches []TextMatch
+	Node        struct {
+		Discussion `graphql:"... on Discussion"`
+	}
+}
@@ -2,6 +2,7 @@ package models
 
 // Signature returns information about signatures including the Email, State and validity (IsValid).
 type Signature struct {
+	Type              string `graphql:"type: __typename" json:"type"`
 	Email             string `json:"email"`
 	IsValid           bool   `json:"is_valid"`
 	State             string `json:"state"`
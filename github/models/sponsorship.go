@@ -0,0 +1,24 @@
+package models
+
+import "github.com/shurcooL/githubv4"
+
+type Sponsorship struct {
+	NodeId           string                      `graphql:"nodeId: id" json:"node_id"`
+	CreatedAt        NullableTime                `json:"created_at"`
+	IsActive         bool                        `json:"is_active"`
+	IsOneTimePayment bool                        `json:"is_one_time_payment"`
+	PrivacyLevel     githubv4.SponsorshipPrivacy `json:"privacy_level"`
+	TierSelectedAt   NullableTime                `json:"tier_selected_at"`
+	Tier             struct {
+		Name                  string `json:"name"`
+		MonthlyPriceInDollars int    `json:"monthly_price_in_dollars"`
+		IsOneTime             bool   `json:"is_one_time"`
+		IsCustomAmount        bool   `json:"is_custom_amount"`
+	} `json:"tier"`
+	SponsorEntity struct {
+		Actor Actor `graphql:"... on Actor" json:"actor"`
+	} `json:"sponsor_entity"`
+	Sponsorable struct {
+		Actor Actor `graphql:"... on Actor" json:"actor"`
+	} `json:"sponsorable"`
+}
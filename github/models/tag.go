@@ -6,12 +6,15 @@ type TagWithCommits struct {
 	Name   string
 	Target struct {
 		Commit Commit `graphql:"... on Commit"`
+		Type   string `graphql:"type: __typename"`
 		Tag    struct {
+			Sha     string `graphql:"sha: oid"`
 			Message string
 			Tagger  struct {
-				Name string
-				Date time.Time
-				User struct {
+				Name  string
+				Email string
+				Date  time.Time
+				User  struct {
 					Login string
 				}
 			}
@@ -21,3 +24,14 @@ type TagWithCommits struct {
 		} `graphql:"... on Tag"`
 	}
 }
+
+// TagRelease is the release created from a tag
+type TagRelease struct {
+	Id           int          `graphql:"id: databaseId" json:"id"`
+	Name         string       `json:"name"`
+	TagName      string       `json:"tag_name"`
+	Url          string       `json:"url"`
+	IsDraft      bool         `json:"is_draft"`
+	IsPrerelease bool         `json:"is_prerelease"`
+	PublishedAt  NullableTime `json:"published_at"`
+}
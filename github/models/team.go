@@ -54,6 +54,19 @@ type TeamWithCounts struct {
 	Repositories struct {
 		TotalCount int
 	}
+	AncestorTeams struct {
+		Nodes []TeamSlug
+	} `graphql:"ancestorTeams: ancestors(first: 100) @include(if:$includeTeamAncestors)"`
+	ImmediateChildTeams struct {
+		Nodes []TeamSlug
+	} `graphql:"immediateChildTeams: childTeams(first: 100, immediateOnly: true) @include(if:$includeTeamChildTeams)"`
+	DescendantTeams struct {
+		Nodes []TeamSlug
+	} `graphql:"descendantTeams: childTeams(first: 100, immediateOnly: false) @include(if:$includeTeamDescendants)"`
+}
+
+type TeamSlug struct {
+	Slug string `json:"slug"`
 }
 
 type TeamMemberWithRole struct {
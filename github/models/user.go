@@ -16,6 +16,16 @@ type BasicUser struct {
 	Url       string       `json:"url"`
 }
 
+// UserProfile is a BasicUser with the public profile fields which are only fetched if required
+type UserProfile struct {
+	BasicUser
+	Company   string `graphql:"company @include(if:$includeUserProfile)" json:"company"`
+	Location  string `graphql:"location @include(if:$includeUserProfile)" json:"location"`
+	Followers struct {
+		TotalCount int `json:"total_count"`
+	} `graphql:"followers @include(if:$includeUserProfile)" json:"followers"`
+}
+
 type User struct {
 	BasicUser
 	AnyPinnableItems                      bool                         `json:"any_pinnable_items"`
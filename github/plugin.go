@@ -18,61 +18,118 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 		DefaultTransform:   transform.FromGo(),
 		DefaultRetryConfig: retryConfig(),
 		TableMap: map[string]*plugin.Table{
-			"github_actions_artifact":                tableGitHubActionsArtifact(),
-			"github_actions_repository_runner":       tableGitHubActionsRepositoryRunner(),
-			"github_actions_repository_secret":       tableGitHubActionsRepositorySecret(),
-			"github_actions_repository_workflow_run": tableGitHubActionsRepositoryWorkflowRun(),
-			"github_audit_log":                       tableGitHubAuditLog(),
-			"github_branch_protection":               tableGitHubBranchProtection(),
-			"github_branch":                          tableGitHubBranch(),
-			"github_commit":                          tableGitHubCommit(),
-			"github_community_profile":               tableGitHubCommunityProfile(),
-			"github_code_owner":                      tableGitHubCodeOwner(),
-			"github_gist":                            tableGitHubGist(),
-			"github_gitignore":                       tableGitHubGitignore(),
-			"github_issue":                           tableGitHubIssue(),
-			"github_issue_comment":                   tableGitHubIssueComment(),
-			"github_license":                         tableGitHubLicense(),
-			"github_my_gist":                         tableGitHubMyGist(),
-			"github_my_issue":                        tableGitHubMyIssue(),
-			"github_my_organization":                 tableGitHubMyOrganization(),
-			"github_my_repository":                   tableGitHubMyRepository(),
-			"github_my_star":                         tableGitHubMyStar(),
-			"github_my_team":                         tableGitHubMyTeam(),
-			"github_organization":                    tableGitHubOrganization(),
-			"github_organization_member":             tableGitHubOrganizationMember(),
-			"github_organization_dependabot_alert":   tableGitHubOrganizationDependabotAlert(),
-			"github_organization_external_identity":  tableGitHubOrganizationExternalIdentity(),
-			"github_pull_request":                    tableGitHubPullRequest(),
-			"github_pull_request_comment":            tableGitHubPullRequestComment(),
-			"github_pull_request_review":             tableGitHubPullRequestReview(),
-			"github_rate_limit":                      tableGitHubRateLimit(),
-			"github_rate_limit_graphql":              tableGitHubRateLimitGraphQL(),
-			"github_release":                         tableGitHubRelease(),
-			"github_repository":                      tableGitHubRepository(),
-			"github_repository_collaborator":         tableGitHubRepositoryCollaborator(),
-			"github_repository_dependabot_alert":     tableGitHubRepositoryDependabotAlert(),
-			"github_repository_deployment":           tableGitHubRepositoryDeployment(),
-			"github_repository_environment":          tableGitHubRepositoryEnvironment(),
-			"github_repository_vulnerability_alert":  tableGitHubRepositoryVulnerabilityAlert(),
-			"github_search_code":                     tableGitHubSearchCode(),
-			"github_search_commit":                   tableGitHubSearchCommit(),
-			"github_search_issue":                    tableGitHubSearchIssue(),
-			"github_search_label":                    tableGitHubSearchLabel(),
-			"github_search_pull_request":             tableGitHubSearchPullRequest(),
-			"github_search_repository":               tableGitHubSearchRepository(),
-			"github_search_topic":                    tableGitHubSearchTopic(),
-			"github_search_user":                     tableGitHubSearchUser(),
-			"github_stargazer":                       tableGitHubStargazer(),
-			"github_tag":                             tableGitHubTag(),
-			"github_team_member":                     tableGitHubTeamMember(),
-			"github_team_repository":                 tableGitHubTeamRepository(),
-			"github_team":                            tableGitHubTeam(),
-			"github_traffic_view_daily":              tableGitHubTrafficViewDaily(),
-			"github_traffic_view_weekly":             tableGitHubTrafficViewWeekly(),
-			"github_tree":                            tableGitHubTree(),
-			"github_user":                            tableGitHubUser(),
-			"github_workflow":                        tableGitHubWorkflow(),
+			"github_actions_artifact":                           tableGitHubActionsArtifact(),
+			"github_actions_repository_runner":                  tableGitHubActionsRepositoryRunner(),
+			"github_actions_repository_secret":                  tableGitHubActionsRepositorySecret(),
+			"github_actions_repository_workflow_run":            tableGitHubActionsRepositoryWorkflowRun(),
+			"github_app":                                        tableGitHubApp(),
+			"github_app_installation":                           tableGitHubAppInstallation(),
+			"github_app_installation_repository":                tableGitHubAppInstallationRepository(),
+			"github_audit_log":                                  tableGitHubAuditLog(),
+			"github_autolink":                                   tableGitHubAutolink(),
+			"github_blame":                                      tableGitHubBlame(),
+			"github_branch_protection":                          tableGitHubBranchProtection(),
+			"github_branch":                                     tableGitHubBranch(),
+			"github_code_owner_error":                           tableGitHubCodeOwnerError(),
+			"github_commit":                                     tableGitHubCommit(),
+			"github_commit_comparison":                          tableGitHubCommitComparison(),
+			"github_community_profile":                          tableGitHubCommunityProfile(),
+			"github_code_owner":                                 tableGitHubCodeOwner(),
+			"github_enterprise_audit_log":                       tableGitHubEnterpriseAuditLog(),
+			"github_enterprise_ip_allow_list":                   tableGitHubEnterpriseIpAllowList(),
+			"github_enterprise_member":                          tableGitHubEnterpriseMember(),
+			"github_enterprise_organization":                    tableGitHubEnterpriseOrganization(),
+			"github_enterprise_runner":                          tableGitHubEnterpriseRunner(),
+			"github_enterprise_runner_group":                    tableGitHubEnterpriseRunnerGroup(),
+			"github_gist":                                       tableGitHubGist(),
+			"github_gist_file":                                  tableGitHubGistFile(),
+			"github_gitignore":                                  tableGitHubGitignore(),
+			"github_interaction_limit":                          tableGitHubInteractionLimit(),
+			"github_issue":                                      tableGitHubIssue(),
+			"github_issue_comment":                              tableGitHubIssueComment(),
+			"github_license":                                    tableGitHubLicense(),
+			"github_merge_queue":                                tableGitHubMergeQueue(),
+			"github_meta":                                       tableGitHubMeta(),
+			"github_meta_ssh_key":                               tableGitHubMetaSSHKey(),
+			"github_my_gist":                                    tableGitHubMyGist(),
+			"github_my_issue":                                   tableGitHubMyIssue(),
+			"github_my_organization":                            tableGitHubMyOrganization(),
+			"github_my_repository":                              tableGitHubMyRepository(),
+			"github_my_star":                                    tableGitHubMyStar(),
+			"github_my_team":                                    tableGitHubMyTeam(),
+			"github_notification":                               tableGitHubNotification(),
+			"github_organization":                               tableGitHubOrganization(),
+			"github_organization_billing_actions":               tableGitHubOrganizationBillingActions(),
+			"github_organization_billing_packages":              tableGitHubOrganizationBillingPackages(),
+			"github_organization_billing_shared_storage":        tableGitHubOrganizationBillingSharedStorage(),
+			"github_organization_credential_authorization":      tableGitHubOrganizationCredentialAuthorization(),
+			"github_organization_custom_property":               tableGitHubOrganizationCustomProperty(),
+			"github_organization_custom_role":                   tableGitHubOrganizationCustomRole(),
+			"github_organization_event":                         tableGitHubOrganizationEvent(),
+			"github_organization_invitation":                    tableGitHubOrganizationInvitation(),
+			"github_organization_ip_allow_list":                 tableGitHubOrganizationIpAllowList(),
+			"github_organization_member":                        tableGitHubOrganizationMember(),
+			"github_organization_dependabot_alert":              tableGitHubOrganizationDependabotAlert(),
+			"github_organization_external_identity":             tableGitHubOrganizationExternalIdentity(),
+			"github_organization_outside_collaborator":          tableGitHubOrganizationOutsideCollaborator(),
+			"github_organization_personal_access_token":         tableGitHubOrganizationPersonalAccessToken(),
+			"github_organization_personal_access_token_request": tableGitHubOrganizationPersonalAccessTokenRequest(),
+			"github_organization_security_manager":              tableGitHubOrganizationSecurityManager(),
+			"github_pull_request":                               tableGitHubPullRequest(),
+			"github_pull_request_comment":                       tableGitHubPullRequestComment(),
+			"github_pull_request_review":                        tableGitHubPullRequestReview(),
+			"github_pull_request_review_comment":                tableGitHubPullRequestReviewComment(),
+			"github_query_cost":                                 tableGitHubQueryCost(),
+			"github_rate_limit":                                 tableGitHubRateLimit(),
+			"github_rate_limit_graphql":                         tableGitHubRateLimitGraphQL(),
+			"github_reaction":                                   tableGitHubReaction(),
+			"github_release":                                    tableGitHubRelease(),
+			"github_repository":                                 tableGitHubRepository(),
+			"github_repository_activity":                        tableGitHubRepositoryActivity(),
+			"github_repository_collaborator":                    tableGitHubRepositoryCollaborator(),
+			"github_repository_content":                         tableGitHubRepositoryContent(),
+			"github_repository_contributor":                     tableGitHubRepositoryContributor(),
+			"github_repository_custom_property":                 tableGitHubRepositoryCustomProperty(),
+			"github_repository_dependabot_alert":                tableGitHubRepositoryDependabotAlert(),
+			"github_repository_deployment":                      tableGitHubRepositoryDeployment(),
+			"github_repository_environment":                     tableGitHubRepositoryEnvironment(),
+			"github_repository_event":                           tableGitHubRepositoryEvent(),
+			"github_repository_fork":                            tableGitHubRepositoryFork(),
+			"github_repository_invitation":                      tableGitHubRepositoryInvitation(),
+			"github_repository_language":                        tableGitHubRepositoryLanguage(),
+			"github_repository_security_settings":               tableGitHubRepositorySecuritySettings(),
+			"github_repository_topic":                           tableGitHubRepositoryTopic(),
+			"github_repository_vulnerability_alert":             tableGitHubRepositoryVulnerabilityAlert(),
+			"github_scim_user":                                  tableGitHubScimUser(),
+			"github_search_code":                                tableGitHubSearchCode(),
+			"github_search_commit":                              tableGitHubSearchCommit(),
+			"github_search_discussion":                          tableGitHubSearchDiscussion(),
+			"github_search_issue":                               tableGitHubSearchIssue(),
+			"github_search_label":                               tableGitHubSearchLabel(),
+			"github_search_pull_request":                        tableGitHubSearchPullRequest(),
+			"github_search_repository":                          tableGitHubSearchRepository(),
+			"github_search_topic":                               tableGitHubSearchTopic(),
+			"github_search_user":                                tableGitHubSearchUser(),
+			"github_sponsorship":                                tableGitHubSponsorship(),
+			"github_stargazer":                                  tableGitHubStargazer(),
+			"github_tag":                                        tableGitHubTag(),
+			"github_tag_protection":                             tableGitHubTagProtection(),
+			"github_team_member":                                tableGitHubTeamMember(),
+			"github_team_repository":                            tableGitHubTeamRepository(),
+			"github_team":                                       tableGitHubTeam(),
+			"github_traffic_clone_daily":                        tableGitHubTrafficCloneDaily(),
+			"github_traffic_popular_path":                       tableGitHubTrafficPopularPath(),
+			"github_traffic_referrer":                           tableGitHubTrafficReferrer(),
+			"github_traffic_view_daily":                         tableGitHubTrafficViewDaily(),
+			"github_traffic_view_weekly":                        tableGitHubTrafficViewWeekly(),
+			"github_tree":                                       tableGitHubTree(),
+			"github_user":                                       tableGitHubUser(),
+			"github_user_email":                                 tableGitHubUserEmail(),
+			"github_user_event":                                 tableGitHubUserEvent(),
+			"github_user_gpg_key":                               tableGitHubUserGPGKey(),
+			"github_user_ssh_key":                               tableGitHubUserSSHKey(),
+			"github_watcher":                                    tableGitHubWatcher(),
+			"github_workflow":                                   tableGitHubWorkflow(),
 		},
 	}
 	return p
@@ -0,0 +1,132 @@
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// queryCost is the API cost of the scans of a table by a connection since the plugin started: the number of REST and
+// GraphQL requests made, and the GraphQL rate limit points used by them. REST requests served from the ETag cache did
+// not count against the rate limit, and requests served from the disk cache were not sent, so they are counted
+// separately.
+type queryCost struct {
+	mu  sync.Mutex
+	row queryCostRow
+}
+
+type queryCostRow struct {
+	ConnectionName     string
+	TableName          string
+	RestRequests       int64
+	RestCachedRequests int64
+	DiskCachedRequests int64
+	GraphQLRequests    int64
+	GraphQLPoints      int64
+	FirstRequestAt     time.Time
+	LastRequestAt      time.Time
+}
+
+type queryCostKey struct {
+	connection string
+	table      string
+}
+
+// queryCosts holds the *queryCost of each connection and table
+var queryCosts sync.Map
+
+func getQueryCost(connection string, table string) *queryCost {
+	cost, _ := queryCosts.LoadOrStore(queryCostKey{connection: connection, table: table}, &queryCost{row: queryCostRow{ConnectionName: connection, TableName: table}})
+	return cost.(*queryCost)
+}
+
+// listQueryCosts returns the API cost of each table scanned by the connection
+func listQueryCosts(connection string) []queryCostRow {
+	var rows []queryCostRow
+	queryCosts.Range(func(key, value interface{}) bool {
+		if key.(queryCostKey).connection == connection {
+			cost := value.(*queryCost)
+			cost.mu.Lock()
+			rows = append(rows, cost.row)
+			cost.mu.Unlock()
+		}
+		return true
+	})
+	return rows
+}
+
+// queryCostTransport records the API cost of the requests made by the client of a table
+type queryCostTransport struct {
+	base http.RoundTripper
+	cost *queryCost
+}
+
+func (t *queryCostTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	graphql := strings.HasSuffix(req.URL.Path, "/graphql")
+	diskCached := resp.Header.Get(diskCacheHeader) != ""
+	points := int64(0)
+	if graphql && !diskCached {
+		points, err = graphQLQueryCost(resp)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	t.cost.mu.Lock()
+	row := &t.cost.row
+	now := time.Now()
+	if row.FirstRequestAt.IsZero() {
+		row.FirstRequestAt = now
+	}
+	row.LastRequestAt = now
+	if diskCached {
+		row.DiskCachedRequests++
+	} else if graphql {
+		row.GraphQLRequests++
+		row.GraphQLPoints += points
+	} else if resp.Header.Get(etagCacheHeader) != "" {
+		row.RestCachedRequests++
+	} else {
+		row.RestRequests++
+	}
+	snapshot := *row
+	t.cost.mu.Unlock()
+
+	plugin.Logger(req.Context()).Debug("queryCostTransport.RoundTrip", "table", snapshot.TableName, "rest_requests", snapshot.RestRequests, "rest_cached_requests", snapshot.RestCachedRequests, "disk_cached_requests", snapshot.DiskCachedRequests, "graphql_requests", snapshot.GraphQLRequests, "graphql_points", snapshot.GraphQLPoints)
+
+	return resp, nil
+}
+
+// graphQLQueryCost returns the rate limit points used by a GraphQL query. The cost is taken from the rateLimit field
+// which the queries of the plugin select, and is otherwise the minimum cost of one point.
+func graphQLQueryCost(resp *http.Response) (int64, error) {
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return 0, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	var result struct {
+		Data struct {
+			RateLimit struct {
+				Cost int64 `json:"cost"`
+			} `json:"rateLimit"`
+		} `json:"data"`
+	}
+	if json.Unmarshal(body, &result) != nil || result.Data.RateLimit.Cost == 0 {
+		return 1, nil
+	}
+	return result.Data.RateLimit.Cost, nil
+}
@@ -0,0 +1,99 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// rateLimitBudget stops requests from using the last min_rate_limit_remaining requests of a rate limit, so that other
+// consumers of the same token are left with some quota. Once the budget is reached, requests either wait for the limit
+// to reset or fail with an error explaining why. The remaining rate limit is tracked from the headers of each response,
+// by token and by rate limit resource.
+type rateLimitBudget struct {
+	base         http.RoundTripper
+	minRemaining int
+	waitForReset bool
+
+	mu    sync.Mutex
+	rates map[rateLimitBudgetKey]rateLimitBudgetRate
+}
+
+type rateLimitBudgetKey struct {
+	authorization string
+	resource      string
+}
+
+type rateLimitBudgetRate struct {
+	limit     int
+	remaining int
+	reset     time.Time
+}
+
+func newRateLimitBudget(minRemaining int, waitForReset bool, base http.RoundTripper) *rateLimitBudget {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &rateLimitBudget{
+		base:         base,
+		minRemaining: minRemaining,
+		waitForReset: waitForReset,
+		rates:        map[rateLimitBudgetKey]rateLimitBudgetRate{},
+	}
+}
+
+func (b *rateLimitBudget) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := rateLimitBudgetKey{authorization: req.Header.Get("Authorization"), resource: rateLimitResource(req)}
+
+	if rate, reached := b.reached(key); reached {
+		if !b.waitForReset {
+			return nil, fmt.Errorf("rate limit budget reached: %d of %d %s requests remaining, which is within min_rate_limit_remaining (%d). The limit resets at %s, set wait_for_reset = true to wait for the reset instead", rate.remaining, rate.limit, key.resource, b.minRemaining, rate.reset.Format(time.RFC3339))
+		}
+
+		plugin.Logger(req.Context()).Warn("rateLimitBudget.RoundTrip", "rate_limit_budget_reached", key.resource, "remaining", rate.remaining, "reset", rate.reset)
+		select {
+		case <-time.After(time.Until(rate.reset)):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+
+	resp, err := b.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	b.record(key, resp)
+	return resp, nil
+}
+
+// reached returns the last known rate of the key and whether it is within the budget and has not yet reset
+func (b *rateLimitBudget) reached(key rateLimitBudgetKey) (rateLimitBudgetRate, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	rate, ok := b.rates[key]
+	return rate, ok && rate.remaining <= b.minRemaining && time.Now().Before(rate.reset)
+}
+
+func (b *rateLimitBudget) record(key rateLimitBudgetKey, resp *http.Response) {
+	limit, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Limit"))
+	if err != nil {
+		return
+	}
+	remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+	reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.rates[key] = rateLimitBudgetRate{limit: limit, remaining: remaining, reset: time.Unix(reset, 0)}
+}
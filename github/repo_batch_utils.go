@@ -0,0 +1,148 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/turbot/steampipe-plugin-github/github/models"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+const (
+	// repositoryBatchWindow is how long concurrent lookups are collected before being sent as a single query
+	repositoryBatchWindow = 20 * time.Millisecond
+	// repositoryBatchSize is the maximum number of repositories fetched by a single query
+	repositoryBatchSize = 25
+)
+
+type repositoryLookup struct {
+	fullName string
+	result   chan repositoryLookupResult
+}
+
+type repositoryLookupResult struct {
+	repository models.Repository
+	err        error
+}
+
+// repositoryBatch is a set of repository lookups, for the same connection and selected columns, which are fetched
+// together using field aliases
+type repositoryBatch struct {
+	ctx     context.Context
+	d       *plugin.QueryData
+	cols    []string
+	lookups []repositoryLookup
+}
+
+var (
+	repositoryBatchesMutex sync.Mutex
+	repositoryBatches      = map[string]*repositoryBatch{}
+	// repositoryLastLookups is when the repositories of each batch key were last looked up
+	repositoryLastLookups = map[string]time.Time{}
+)
+
+// getRepositoryBatched returns the repository with the given full name. The SDK calls the list function concurrently
+// for each value of an IN list, so the lookups made within the batch window are coalesced into a single GraphQL query
+// rather than one query per repository. A lookup with no other lookup of the same columns in the preceding window is
+// sent immediately, so that the lookup of a single repository is not delayed.
+func getRepositoryBatched(ctx context.Context, d *plugin.QueryData, fullName string) (models.Repository, error) {
+	cols := slices.Clone(d.QueryContext.Columns)
+	slices.Sort(cols)
+	key := d.Connection.Name + "|" + strings.Join(cols, ",")
+
+	lookup := repositoryLookup{fullName: fullName, result: make(chan repositoryLookupResult, 1)}
+
+	repositoryBatchesMutex.Lock()
+	now := time.Now()
+	last, seen := repositoryLastLookups[key]
+	repositoryLastLookups[key] = now
+	batch, ok := repositoryBatches[key]
+	if !ok && (!seen || now.Sub(last) > repositoryBatchWindow) {
+		repositoryBatchesMutex.Unlock()
+		single := &repositoryBatch{ctx: ctx, d: d, cols: cols, lookups: []repositoryLookup{lookup}}
+		single.run()
+		result := <-lookup.result
+		return result.repository, result.err
+	}
+	if !ok {
+		// The batch outlives the query data of the lookup which created it,
+		// so it must not be cancelled along with it
+		batch = &repositoryBatch{ctx: context.WithoutCancel(ctx), d: d, cols: cols}
+		repositoryBatches[key] = batch
+		time.AfterFunc(repositoryBatchWindow, func() { flushRepositoryBatch(key, batch) })
+	}
+	batch.lookups = append(batch.lookups, lookup)
+	if len(batch.lookups) >= repositoryBatchSize {
+		delete(repositoryBatches, key)
+		go batch.run()
+	}
+	repositoryBatchesMutex.Unlock()
+
+	select {
+	case result := <-lookup.result:
+		return result.repository, result.err
+	case <-ctx.Done():
+		return models.Repository{}, ctx.Err()
+	}
+}
+
+func flushRepositoryBatch(key string, batch *repositoryBatch) {
+	repositoryBatchesMutex.Lock()
+	// The batch has already been sent if it was filled before the window ended
+	if repositoryBatches[key] != batch {
+		repositoryBatchesMutex.Unlock()
+		return
+	}
+	delete(repositoryBatches, key)
+	repositoryBatchesMutex.Unlock()
+
+	batch.run()
+}
+
+func (b *repositoryBatch) run() {
+	fields := []reflect.StructField{
+		{Name: "RateLimit", Type: reflect.TypeOf(models.RateLimit{})},
+	}
+
+	variables := map[string]interface{}{}
+	appendRepoColumnIncludes(&variables, b.cols)
+
+	for i, lookup := range b.lookups {
+		owner, repoName := parseRepoFullName(lookup.fullName)
+		variables[fmt.Sprintf("owner%d", i)] = githubv4.String(owner)
+		variables[fmt.Sprintf("name%d", i)] = githubv4.String(repoName)
+		fields = append(fields, reflect.StructField{
+			Name: fmt.Sprintf("Repository%d", i),
+			Type: reflect.TypeOf(models.Repository{}),
+			Tag:  reflect.StructTag(fmt.Sprintf(`graphql:"repository%d: repository(owner: $owner%d, name: $name%d)"`, i, i, i)),
+		})
+	}
+
+	query := reflect.New(reflect.StructOf(fields))
+
+	client := connectV4(b.ctx, b.d)
+	err := client.Query(b.ctx, query.Interface(), variables)
+	rateLimit := query.Elem().Field(0).Interface().(models.RateLimit)
+	plugin.Logger(b.ctx).Debug(rateLimitLogString("github_repository", &rateLimit))
+	if err != nil {
+		plugin.Logger(b.ctx).Error("github_repository", "api_error", err)
+	}
+
+	for i, lookup := range b.lookups {
+		repo := query.Elem().Field(i + 1).Interface().(models.Repository)
+
+		// The repositories which could be resolved are returned along with
+		// the errors for those which could not
+		if err != nil && repo.NodeId == "" {
+			lookup.result <- repositoryLookupResult{err: err}
+			continue
+		}
+		lookup.result <- repositoryLookupResult{repository: repo}
+	}
+}
@@ -833,3 +833,11 @@ func repoHydrateWatchersCount(_ context.Context, _ *plugin.QueryData, h *plugin.
 	}
 	return nil, nil
 }
+
+func repoHydrateRawJSON(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	repo, err := extractRepoFromHydrateItem(h)
+	if err != nil {
+		return nil, err
+	}
+	return getRepositoryV3RawJSON(ctx, d, repo.Owner.Login, repo.Name)
+}
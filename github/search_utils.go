@@ -0,0 +1,79 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/google/go-github/v55/github"
+)
+
+// searchScores fetches the relevance scores of the results of a REST search API, which the GraphQL search does not
+// return. The pages are fetched lazily as the GraphQL results are streamed, so no more than one REST request is made
+// per page of GraphQL results. The go-github client does not decode the score of every type of result, so the search
+// is made with raw requests.
+type searchScores struct {
+	client  *github.Client
+	path    string
+	query   string
+	perPage int
+	page    int
+	done    bool
+	fetched int
+	scores  map[string]float64
+}
+
+func newSearchScores(client *github.Client, path string, query string, perPage int) *searchScores {
+	return &searchScores{
+		client:  client,
+		path:    path,
+		query:   query,
+		perPage: perPage,
+		page:    1,
+		scores:  map[string]float64{},
+	}
+}
+
+// get returns the score of the result with the node ID, at the given position in the GraphQL results. Pages are only
+// fetched up to that position, so nil is returned if the REST results are ordered differently.
+func (s *searchScores) get(ctx context.Context, nodeId string, position int) (*float64, error) {
+	for !s.done && s.fetched <= position {
+		if err := s.fetchPage(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if score, ok := s.scores[nodeId]; ok {
+		return &score, nil
+	}
+	return nil, nil
+}
+
+func (s *searchScores) fetchPage(ctx context.Context) error {
+	req, err := s.client.NewRequest("GET", fmt.Sprintf("%s?q=%s&per_page=%v&page=%v", s.path, url.QueryEscape(s.query), s.perPage, s.page), nil)
+	if err != nil {
+		return err
+	}
+
+	var result struct {
+		Items []struct {
+			NodeID string  `json:"node_id"`
+			Score  float64 `json:"score"`
+		} `json:"items"`
+	}
+	resp, err := s.client.Do(ctx, req, &result)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range result.Items {
+		s.scores[item.NodeID] = item.Score
+	}
+	s.fetched += len(result.Items)
+
+	if resp.NextPage == 0 || len(result.Items) == 0 {
+		s.done = true
+	}
+	s.page = resp.NextPage
+	return nil
+}
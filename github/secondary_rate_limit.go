@@ -0,0 +1,150 @@
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+const (
+	// defaultSecondaryRateLimitMaxRetries is the number of times a request is retried after hitting a secondary rate
+	// limit, unless set by secondary_rate_limit_max_retries
+	defaultSecondaryRateLimitMaxRetries = 5
+	// defaultSecondaryRateLimitMaxWait is the longest time in seconds to wait before retrying a request, unless set by
+	// secondary_rate_limit_max_wait
+	defaultSecondaryRateLimitMaxWait = 300
+	// secondaryRateLimitMinWait is the time to wait before retrying when the response has no Retry-After header, as
+	// recommended by GitHub. It is doubled on each retry.
+	secondaryRateLimitMinWait = time.Minute
+)
+
+// secondaryRateLimitTransport retries requests which were rejected for exceeding a secondary rate limit, which GitHub
+// applies to bursts of requests regardless of the remaining primary rate limit. The wait before each retry follows
+// the Retry-After header, plus jitter so that concurrent requests do not all retry at once.
+type secondaryRateLimitTransport struct {
+	base       http.RoundTripper
+	maxRetries int
+	maxWait    time.Duration
+}
+
+func newSecondaryRateLimitTransport(config githubConfig, base http.RoundTripper) *secondaryRateLimitTransport {
+	maxRetries := defaultSecondaryRateLimitMaxRetries
+	if config.SecondaryRateLimitMaxRetries != nil {
+		maxRetries = *config.SecondaryRateLimitMaxRetries
+	}
+	maxWait := defaultSecondaryRateLimitMaxWait
+	if config.SecondaryRateLimitMaxWait != nil {
+		maxWait = *config.SecondaryRateLimitMaxWait
+	}
+	return &secondaryRateLimitTransport{base: base, maxRetries: maxRetries, maxWait: time.Duration(maxWait) * time.Second}
+}
+
+func (t *secondaryRateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		retry := req.Clone(req.Context())
+		if body != nil {
+			retry.Body = io.NopCloser(bytes.NewReader(body))
+			retry.ContentLength = int64(len(body))
+		}
+
+		resp, err := t.base.RoundTrip(retry)
+		if err != nil || attempt == t.maxRetries {
+			return resp, err
+		}
+
+		limited, err := isSecondaryRateLimitedResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+		if !limited {
+			return resp, nil
+		}
+
+		wait := secondaryRateLimitMinWait << attempt
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+			wait = time.Duration(seconds) * time.Second
+		}
+		// Retrying any sooner than requested would only hit the limit again,
+		// so the error is returned if the wait is too long
+		if wait > t.maxWait {
+			return resp, nil
+		}
+		wait += time.Duration(rand.Int63n(int64(wait/4) + int64(time.Second)))
+
+		plugin.Logger(req.Context()).Warn("secondaryRateLimitTransport.RoundTrip", "secondary_rate_limit", req.URL.Path, "attempt", attempt+1, "wait", wait)
+		resp.Body.Close()
+
+		select {
+		case <-time.After(wait):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+}
+
+// isSecondaryRateLimitedResponse returns true if the request was rejected for exceeding a secondary rate limit. The
+// REST API responds with a 403 or 429 status and a Retry-After header or a message in the body, while the GraphQL API
+// may also respond with a secondary rate limit error.
+func isSecondaryRateLimitedResponse(resp *http.Response) (bool, error) {
+	switch {
+	case resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests:
+		if resp.Header.Get("Retry-After") != "" {
+			return true, nil
+		}
+	case resp.StatusCode == http.StatusOK && strings.HasSuffix(resp.Request.URL.Path, "/graphql"):
+	default:
+		return false, nil
+	}
+
+	// Bodies are only buffered for the responses which may be rate limited
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return false, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+	return isSecondaryRateLimitMessage(respBody), nil
+}
+
+// isSecondaryRateLimitMessage returns true if the error message of a REST response, or any of the top-level errors of
+// a GraphQL response, reports a secondary rate limit. The data of a GraphQL response is never matched, as it may
+// contain user content such as issue bodies.
+func isSecondaryRateLimitMessage(body []byte) bool {
+	var payload struct {
+		Message string `json:"message"`
+		Errors  []struct {
+			Type    string `json:"type"`
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return false
+	}
+
+	if strings.Contains(strings.ToLower(payload.Message), "secondary rate limit") {
+		return true
+	}
+	for _, e := range payload.Errors {
+		if strings.Contains(strings.ToLower(e.Type), "secondary_rate_limit") || strings.Contains(strings.ToLower(e.Message), "secondary rate limit") {
+			return true
+		}
+	}
+	return false
+}
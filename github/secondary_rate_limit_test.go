@@ -0,0 +1,73 @@
+package github
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestIsSecondaryRateLimitedResponse(t *testing.T) {
+	graphqlURL, _ := url.Parse("https://api.github.com/graphql")
+
+	cases := []struct {
+		name   string
+		status int
+		body   string
+		want   bool
+	}{
+		{
+			name:   "graphql error message",
+			status: http.StatusOK,
+			body:   `{"errors":[{"message":"You have exceeded a secondary rate limit. Please wait a few minutes before you try again."}]}`,
+			want:   true,
+		},
+		{
+			name:   "rest error message",
+			status: http.StatusForbidden,
+			body:   `{"message":"You have exceeded a secondary rate limit and have been temporarily blocked from content creation."}`,
+			want:   true,
+		},
+		{
+			name:   "phrase in graphql data",
+			status: http.StatusOK,
+			body:   `{"data":{"repository":{"issue":{"title":"Handle the secondary rate limit","body":"We hit a secondary rate limit"}}}}`,
+			want:   false,
+		},
+		{
+			name:   "unrelated graphql error",
+			status: http.StatusOK,
+			body:   `{"data":null,"errors":[{"type":"NOT_FOUND","message":"Could not resolve to a Repository with the name 'octo/repo'."}]}`,
+			want:   false,
+		},
+		{
+			name:   "not json",
+			status: http.StatusOK,
+			body:   `secondary rate limit`,
+			want:   false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			resp := &http.Response{
+				StatusCode: c.status,
+				Header:     http.Header{},
+				Body:       io.NopCloser(bytes.NewBufferString(c.body)),
+				Request:    &http.Request{URL: graphqlURL},
+			}
+			got, err := isSecondaryRateLimitedResponse(resp)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != c.want {
+				t.Errorf("got %v, want %v", got, c.want)
+			}
+			body, _ := io.ReadAll(resp.Body)
+			if string(body) != c.body {
+				t.Errorf("response body was not restored")
+			}
+		})
+	}
+}
@@ -63,6 +63,7 @@ func tableGitHubActionsRepositoryWorkflowRun() *plugin.Table {
 			{Name: "actor_login", Type: proto.ColumnType_STRING, Description: "The login of the user whom initiated the first instance of the workflow run.", Transform: transform.FromField("Actor.Login")},
 			{Name: "triggering_actor", Type: proto.ColumnType_JSON, Description: "The user whom initiated the latest instance of this workflow run."},
 			{Name: "triggering_actor_login", Type: proto.ColumnType_STRING, Description: "The login of the user whom initiated the latest instance of this workflow run.", Transform: transform.FromField("TriggeringActor.Login")},
+			{Name: "raw_json", Type: proto.ColumnType_JSON, Hydrate: workflowRunHydrateRawJSON, Transform: transform.FromValue(), Description: "The workflow run as returned by the REST API, including the fields which have no column. Fetching it requires an additional API request per workflow run."},
 		},
 	}
 }
@@ -157,3 +158,8 @@ func tableGitHubRepoWorkflowRunGet(ctx context.Context, d *plugin.QueryData, h *
 
 	return workflowRun, nil
 }
+
+func workflowRunHydrateRawJSON(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	run := h.Item.(*github.WorkflowRun)
+	return getRawJSON(ctx, d, run.GetURL())
+}
@@ -0,0 +1,57 @@
+package github
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubApp() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_app",
+		Description: "GitHub Apps are integrations that can be installed on organizations, users and repositories.",
+		List: &plugin.ListConfig{
+			KeyColumns:        plugin.SingleColumn("slug"),
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubAppGet,
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "slug", Type: proto.ColumnType_STRING, Description: "The URL-friendly name of the app."},
+			{Name: "id", Type: proto.ColumnType_INT, Transform: transform.FromField("ID"), Description: "Unique ID of the app."},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the app."},
+			{Name: "owner_login", Type: proto.ColumnType_STRING, Transform: transform.FromField("Owner.Login"), Description: "The login of the user or organization that owns the app."},
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "The description of the app."},
+			// Other columns
+			{Name: "node_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("NodeID"), Description: "The node ID of the app."},
+			{Name: "owner", Type: proto.ColumnType_JSON, Transform: transform.FromField("Owner").NullIfZero(), Description: "The user or organization that owns the app."},
+			{Name: "permissions", Type: proto.ColumnType_JSON, Description: "The permissions the app requests, keyed by the permission name."},
+			{Name: "events", Type: proto.ColumnType_JSON, Description: "The webhook events the app subscribes to."},
+			{Name: "installations_count", Type: proto.ColumnType_INT, Description: "The number of installations of the app. Only returned when authenticated as the app."},
+			{Name: "external_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("ExternalURL"), Description: "The URL of the app's website."},
+			{Name: "html_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("HTMLURL"), Description: "The URL of the app's page on GitHub."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("CreatedAt").Transform(convertTimestamp), Description: "Time when the app was created."},
+			{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("UpdatedAt").Transform(convertTimestamp), Description: "Time when the app was last updated."},
+		},
+	}
+}
+
+func tableGitHubAppGet(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+
+	slug := d.EqualsQuals["slug"].GetStringValue()
+
+	app, _, err := client.Apps.Get(ctx, slug)
+	if err != nil {
+		plugin.Logger(ctx).Error("github_app", "api_error", err)
+		return nil, err
+	}
+
+	if app != nil {
+		d.StreamListItem(ctx, app)
+	}
+
+	return nil, nil
+}
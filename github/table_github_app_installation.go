@@ -0,0 +1,94 @@
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v55/github"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubAppInstallation() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_app_installation",
+		Description: "GitHub App installations in the given organization.",
+		List: &plugin.ListConfig{
+			KeyColumns:        plugin.SingleColumn("organization"),
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubAppInstallationList,
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "organization", Type: proto.ColumnType_STRING, Transform: transform.FromQual("organization"), Description: "The organization the app is installed in."},
+			{Name: "id", Type: proto.ColumnType_INT, Transform: transform.FromField("ID"), Description: "Unique ID of the installation."},
+			{Name: "app_id", Type: proto.ColumnType_INT, Transform: transform.FromField("AppID"), Description: "The ID of the installed app."},
+			{Name: "app_slug", Type: proto.ColumnType_STRING, Description: "The URL-friendly name of the installed app."},
+			{Name: "repository_selection", Type: proto.ColumnType_STRING, Description: "Whether the installation can access all repositories of the organization or only selected ones."},
+			{Name: "permissions", Type: proto.ColumnType_JSON, Description: "The permissions granted to the installation, keyed by the permission name."},
+			{Name: "events", Type: proto.ColumnType_JSON, Description: "The webhook events the installation is subscribed to."},
+			{Name: "suspended", Type: proto.ColumnType_BOOL, Transform: transform.FromValue().Transform(isAppInstallationSuspended), Description: "If true, the installation has been suspended."},
+			// Other columns
+			{Name: "node_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("NodeID"), Description: "The node ID of the installation."},
+			{Name: "target_id", Type: proto.ColumnType_INT, Transform: transform.FromField("TargetID"), Description: "The ID of the account the app is installed on."},
+			{Name: "target_type", Type: proto.ColumnType_STRING, Description: "The type of the account the app is installed on, e.g. Organization."},
+			{Name: "account_login", Type: proto.ColumnType_STRING, Transform: transform.FromField("Account.Login"), Description: "The login of the account the app is installed on."},
+			{Name: "single_file_paths", Type: proto.ColumnType_JSON, Description: "The file paths the installation can access when it is restricted to single files."},
+			{Name: "suspended_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("SuspendedAt").Transform(convertTimestamp), Description: "Time when the installation was suspended."},
+			{Name: "suspended_by", Type: proto.ColumnType_STRING, Transform: transform.FromField("SuspendedBy.Login"), Description: "The login of the user who suspended the installation."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("CreatedAt").Transform(convertTimestamp), Description: "Time when the app was installed."},
+			{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("UpdatedAt").Transform(convertTimestamp), Description: "Time when the installation was last updated."},
+			{Name: "html_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("HTMLURL"), Description: "The URL of the installation settings in the GitHub UI."},
+		},
+	}
+}
+
+func tableGitHubAppInstallationList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+
+	org := d.EqualsQuals["organization"].GetStringValue()
+	opts := &github.ListOptions{PerPage: 100}
+
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit < int64(opts.PerPage) {
+			opts.PerPage = int(*limit)
+		}
+	}
+
+	for {
+		result, resp, err := client.Organizations.ListInstallations(ctx, org, opts)
+		if err != nil {
+			plugin.Logger(ctx).Error("github_app_installation", "api_error", err)
+			return nil, err
+		}
+
+		for _, i := range result.Installations {
+			if i != nil {
+				d.StreamListItem(ctx, i)
+			}
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return nil, nil
+}
+
+func isAppInstallationSuspended(_ context.Context, input *transform.TransformData) (interface{}, error) {
+	installation, ok := input.Value.(*github.Installation)
+	if !ok {
+		return nil, nil
+	}
+	return installation.SuspendedAt != nil, nil
+}
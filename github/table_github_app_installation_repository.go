@@ -0,0 +1,134 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v55/github"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type appInstallationRepository struct {
+	InstallationID int64
+	AppSlug        string
+	Repository     *github.Repository
+}
+
+func tableGitHubAppInstallationRepository() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_app_installation_repository",
+		Description: "Repositories the GitHub App installations in the given organization have been granted access to.",
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "organization", Require: plugin.Required},
+				{Name: "installation_id", Require: plugin.Optional},
+			},
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubAppInstallationRepositoryList,
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "organization", Type: proto.ColumnType_STRING, Transform: transform.FromQual("organization"), Description: "The organization the app is installed in."},
+			{Name: "installation_id", Type: proto.ColumnType_INT, Description: "The ID of the installation."},
+			{Name: "app_slug", Type: proto.ColumnType_STRING, Description: "The URL-friendly name of the installed app."},
+			{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Repository.FullName"), Description: "Full name of the repository the installation can access."},
+			// Other columns
+			{Name: "repository_id", Type: proto.ColumnType_INT, Transform: transform.FromField("Repository.ID"), Description: "The ID of the repository."},
+			{Name: "repository_node_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Repository.NodeID"), Description: "The node ID of the repository."},
+			{Name: "private", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Repository.Private"), Description: "If true, the repository is private."},
+			{Name: "visibility", Type: proto.ColumnType_STRING, Transform: transform.FromField("Repository.Visibility"), Description: "The visibility of the repository, e.g. public, private or internal."},
+			{Name: "archived", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Repository.Archived"), Description: "If true, the repository is archived."},
+		},
+	}
+}
+
+func tableGitHubAppInstallationRepositoryList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+
+	quals := d.EqualsQuals
+	org := quals["organization"].GetStringValue()
+
+	installations, err := listOrganizationAppInstallations(ctx, client, org)
+	if err != nil {
+		plugin.Logger(ctx).Error("github_app_installation_repository", "api_error", err)
+		return nil, err
+	}
+
+	// An installation access token can only list the repositories of its own
+	// installation, any other token must be a user access token of a GitHub
+	// App as the endpoint does not accept personal access tokens
+	creds, _ := getAppCredentials(GetConfig(d.Connection))
+
+	for _, installation := range installations {
+		if quals["installation_id"] != nil && installation.GetID() != quals["installation_id"].GetInt64Value() {
+			continue
+		}
+		if creds != nil && installation.GetID() != creds.InstallationID {
+			continue
+		}
+
+		opts := &github.ListOptions{PerPage: 100}
+		for {
+			var result *github.ListRepositories
+			var resp *github.Response
+			if creds != nil {
+				result, resp, err = client.Apps.ListRepos(ctx, opts)
+			} else {
+				result, resp, err = client.Apps.ListUserRepos(ctx, installation.GetID(), opts)
+			}
+			if err != nil {
+				plugin.Logger(ctx).Error("github_app_installation_repository", "api_error", err)
+				if creds == nil && isNotFoundError([]string{"403"})(err) {
+					return nil, fmt.Errorf("listing the repositories of an app installation requires authenticating as the installation or with a user access token of the app: %w", err)
+				}
+				return nil, err
+			}
+
+			for _, r := range result.Repositories {
+				d.StreamListItem(ctx, appInstallationRepository{
+					InstallationID: installation.GetID(),
+					AppSlug:        installation.GetAppSlug(),
+					Repository:     r,
+				})
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+
+			if resp.NextPage == 0 {
+				break
+			}
+
+			opts.Page = resp.NextPage
+		}
+	}
+
+	return nil, nil
+}
+
+func listOrganizationAppInstallations(ctx context.Context, client *github.Client, org string) ([]*github.Installation, error) {
+	opts := &github.ListOptions{PerPage: 100}
+
+	var installations []*github.Installation
+	for {
+		result, resp, err := client.Organizations.ListInstallations(ctx, org, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		installations = append(installations, result.Installations...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return installations, nil
+}
@@ -15,40 +15,61 @@ func tableGitHubAuditLog() *plugin.Table {
 		Name:        "github_audit_log",
 		Description: "Gets the audit logs for an organization.",
 		List: &plugin.ListConfig{
-			KeyColumns: []*plugin.KeyColumn{
+			KeyColumns: append([]*plugin.KeyColumn{
 				{Name: "organization", Require: plugin.Required},
-				{Name: "phrase", Require: plugin.Optional},
-				{Name: "include", Require: plugin.Optional},
-				{Name: "action", Require: plugin.Optional},
-				{Name: "actor", Require: plugin.Optional},
-				{Name: "created_at", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<=", "="}},
-			},
+			}, gitHubAuditLogKeyColumns()...),
 			Hydrate: tableGitHubAuditLogList,
 		},
-		Columns: []*plugin.Column{
+		Columns: append([]*plugin.Column{
 			{Name: "organization", Type: proto.ColumnType_STRING, Transform: transform.FromQual("organization"), Description: "The GitHub organization."},
-			{Name: "phrase", Type: proto.ColumnType_STRING, Transform: transform.FromQual("phrase"), Description: "The search phrase for your audit events."},
-			{Name: "include", Type: proto.ColumnType_STRING, Transform: transform.FromQual("include"), Description: "The event types to include: web, git, all."},
-
-			// Top columns
-			{Name: "id", Type: proto.ColumnType_STRING, Description: "The id of the audit event.", Transform: transform.FromField("DocumentID")},
-			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Description: "The timestamp of the audit event.", Transform: transform.FromField("CreatedAt").Transform(convertTimestamp)},
-			{Name: "action", Type: proto.ColumnType_STRING, Description: "The action performed."},
-			{Name: "actor", Type: proto.ColumnType_STRING, Description: "The GitHub user who performed the action."},
-			{Name: "actor_location", Type: proto.ColumnType_JSON, Description: "The actor's location at the moment of the action."},
-
-			// Optional columns, depending on the audit event
-			{Name: "team", Type: proto.ColumnType_STRING, Description: "The GitHub team, when the action relates to a team."},
-			{Name: "user_login", Type: proto.ColumnType_STRING, Description: "The GitHub user, when the action relates to a user.", Transform: transform.FromField("User")},
-			{Name: "repo", Type: proto.ColumnType_STRING, Description: "The GitHub repository, when the action relates to a repository."},
-			{Name: "data", Type: proto.ColumnType_JSON, Description: "Additional data relating to the audit event."},
-		},
+		}, gitHubAuditLogColumns()...),
 	}
 }
 
-func tableGitHubAuditLogList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+func gitHubAuditLogKeyColumns() []*plugin.KeyColumn {
+	return []*plugin.KeyColumn{
+		{Name: "phrase", Require: plugin.Optional},
+		{Name: "include", Require: plugin.Optional},
+		{Name: "action", Require: plugin.Optional},
+		{Name: "actor", Require: plugin.Optional},
+		{Name: "repo", Require: plugin.Optional},
+		{Name: "user_login", Require: plugin.Optional},
+		{Name: "created_at", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<=", "="}},
+	}
+}
+
+func gitHubAuditLogColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{Name: "phrase", Type: proto.ColumnType_STRING, Transform: transform.FromQual("phrase"), Description: "The search phrase for your audit events."},
+		{Name: "include", Type: proto.ColumnType_STRING, Transform: transform.FromQual("include"), Description: "The event types to include: web, git, all."},
+
+		// Top columns
+		{Name: "id", Type: proto.ColumnType_STRING, Description: "The id of the audit event.", Transform: transform.FromField("DocumentID")},
+		{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Description: "The timestamp of the audit event.", Transform: transform.FromField("CreatedAt").Transform(convertTimestamp)},
+		{Name: "action", Type: proto.ColumnType_STRING, Description: "The action performed."},
+		{Name: "actor", Type: proto.ColumnType_STRING, Description: "The GitHub user who performed the action."},
+		{Name: "actor_location", Type: proto.ColumnType_JSON, Description: "The actor's location at the moment of the action."},
+		{Name: "actor_ip", Type: proto.ColumnType_STRING, Transform: transform.FromField("ActorIP"), Description: "The IP address of the actor, if IP disclosure is enabled for the organization."},
+		{Name: "user_agent", Type: proto.ColumnType_STRING, Description: "The user agent of the client which performed the action."},
+		{Name: "operation_type", Type: proto.ColumnType_STRING, Description: "The type of operation performed, e.g. create, modify, remove, access or authentication."},
+
+		// Optional columns, depending on the audit event
+		{Name: "team", Type: proto.ColumnType_STRING, Description: "The GitHub team, when the action relates to a team."},
+		{Name: "user_login", Type: proto.ColumnType_STRING, Description: "The GitHub user, when the action relates to a user.", Transform: transform.FromField("User")},
+		{Name: "repo", Type: proto.ColumnType_STRING, Description: "The GitHub repository, when the action relates to a repository."},
+		{Name: "visibility", Type: proto.ColumnType_STRING, Description: "The repository visibility, when the action relates to a repository."},
+		{Name: "permission", Type: proto.ColumnType_STRING, Description: "The permission level granted, when the action relates to a membership or access change."},
+		{Name: "old_permission", Type: proto.ColumnType_STRING, Description: "The previous permission level, when the action relates to a membership or access change."},
+		{Name: "hashed_token", Type: proto.ColumnType_STRING, Description: "The SHA-256 hash of the token used to perform the action, if the action was authenticated with a token."},
+		{Name: "programmatic_access_type", Type: proto.ColumnType_STRING, Description: "The type of credential used to perform the action, e.g. a personal access token or GitHub App token."},
+		{Name: "transport_protocol_name", Type: proto.ColumnType_STRING, Description: "The protocol used to transfer Git data, e.g. HTTP or SSH, for Git events."},
+		{Name: "data", Type: proto.ColumnType_JSON, Description: "Additional data relating to the audit event."},
+	}
+}
+
+// buildAuditLogOptions converts the quals shared by the audit log tables into request options
+func buildAuditLogOptions(d *plugin.QueryData) *github.GetAuditLogOptions {
 	quals := d.EqualsQuals
-	org := quals["organization"].GetStringValue()
 	phrase := quals["phrase"].GetStringValue()
 	include := quals["include"].GetStringValue()
 
@@ -82,7 +103,15 @@ func tableGitHubAuditLogList(ctx context.Context, d *plugin.QueryData, h *plugin
 		opts.Phrase = &phrase
 	}
 
-	client := connect(ctx, d)
+	if quals["repo"] != nil {
+		phrase += " repo:" + quals["repo"].GetStringValue()
+		opts.Phrase = &phrase
+	}
+
+	if quals["user_login"] != nil {
+		phrase += " user:" + quals["user_login"].GetStringValue()
+		opts.Phrase = &phrase
+	}
 
 	// Reduce the basic request limit down if the user has only requested a small number of rows
 	limit := d.QueryContext.Limit
@@ -92,9 +121,18 @@ func tableGitHubAuditLogList(ctx context.Context, d *plugin.QueryData, h *plugin
 		}
 	}
 
+	return opts
+}
+
+func tableGitHubAuditLogList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	org := d.EqualsQuals["organization"].GetStringValue()
+	opts := buildAuditLogOptions(d)
+	client := connect(ctx, d)
+
 	for {
 		auditResults, resp, err := client.Organizations.GetAuditLog(ctx, org, opts)
 		if err != nil {
+			plugin.Logger(ctx).Error("github_audit_log", "api_error", err)
 			return nil, err
 		}
 
@@ -0,0 +1,73 @@
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v55/github"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubAutolink() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_autolink",
+		Description: "Autolink references configured on the given repository, which turn references to external resources into links.",
+		List: &plugin.ListConfig{
+			Hydrate:           tableGitHubAutolinkList,
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			KeyColumns:        plugin.SingleColumn("repository_full_name"),
+		},
+		Columns: []*plugin.Column{
+			{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromQual("repository_full_name"), Description: "Full name of the repository that contains the autolink."},
+			{Name: "id", Type: proto.ColumnType_INT, Transform: transform.FromField("ID"), Description: "The ID of the autolink."},
+			{Name: "key_prefix", Type: proto.ColumnType_STRING, Description: "The prefix that generates a link when followed by a reference, for example JIRA-."},
+			{Name: "url_template", Type: proto.ColumnType_STRING, Transform: transform.FromField("URLTemplate"), Description: "The URL the reference links to, where <num> is replaced by the reference."},
+			{Name: "is_alphanumeric", Type: proto.ColumnType_BOOL, Description: "If true, the reference may contain letters as well as numbers, otherwise only numbers are linked."},
+		},
+	}
+}
+
+func tableGitHubAutolinkList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+
+	fullName := d.EqualsQuals["repository_full_name"].GetStringValue()
+	owner, repo := parseRepoFullName(fullName)
+
+	opts := &github.ListOptions{PerPage: 100}
+
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit < int64(opts.PerPage) {
+			opts.PerPage = int(*limit)
+		}
+	}
+
+	for {
+		autolinks, resp, err := client.Repositories.ListAutolinks(ctx, owner, repo, opts)
+		if err != nil {
+			plugin.Logger(ctx).Error("github_autolink", "api_error", err)
+			return nil, err
+		}
+
+		for _, a := range autolinks {
+			if a != nil {
+				d.StreamListItem(ctx, a)
+			}
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,93 @@
+package github
+
+import (
+	"context"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/turbot/steampipe-plugin-github/github/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubBlame() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_blame",
+		Description: "Blame ranges for a file in the given repository at a given ref.",
+		List: &plugin.ListConfig{
+			Hydrate:           tableGitHubBlameList,
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "repository_full_name", Require: plugin.Required},
+				{Name: "path", Require: plugin.Required},
+				{Name: "ref", Require: plugin.Optional, CacheMatch: "exact"},
+			},
+		},
+		Columns: []*plugin.Column{
+			{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromQual("repository_full_name"), Description: "Full name of the repository that contains the file."},
+			{Name: "path", Type: proto.ColumnType_STRING, Transform: transform.FromQual("path"), Description: "The path of the file to blame."},
+			{Name: "ref", Type: proto.ColumnType_STRING, Transform: transform.FromQual("ref"), Default: "HEAD", Description: "The ref (branch, tag or commit SHA) the file is blamed at. Defaults to HEAD."},
+			{Name: "starting_line", Type: proto.ColumnType_INT, Description: "The starting line for the range."},
+			{Name: "ending_line", Type: proto.ColumnType_INT, Description: "The ending line for the range."},
+			{Name: "age", Type: proto.ColumnType_INT, Description: "The recency of the change, from 1 (new) to 10 (old). This is calculated as a 2-quantile and determines the length of distance between the median age of all the changes in the file and the recency of the current range's change."},
+			{Name: "commit_sha", Type: proto.ColumnType_STRING, Transform: transform.FromField("Commit.Sha"), Description: "SHA of the commit that last changed the lines in the range."},
+			{Name: "commit_message", Type: proto.ColumnType_STRING, Transform: transform.FromField("Commit.Message"), Description: "Message of the commit that last changed the lines in the range."},
+			{Name: "commit_authored_date", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Commit.AuthoredDate").NullIfZero().Transform(convertTimestamp), Description: "Timestamp when the commit that last changed the lines in the range was authored."},
+			{Name: "author_login", Type: proto.ColumnType_STRING, Transform: transform.FromField("Commit.Author.User.Login"), Description: "The login name of the author of the commit."},
+			{Name: "author_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Commit.Author.Name"), Description: "The name of the author of the commit."},
+			{Name: "author_email", Type: proto.ColumnType_STRING, Transform: transform.FromField("Commit.Author.Email"), Description: "The email of the author of the commit."},
+			{Name: "commit", Type: proto.ColumnType_JSON, Transform: transform.FromField("Commit").NullIfZero(), Description: "The commit that last changed the lines in the range."},
+		},
+	}
+}
+
+func tableGitHubBlameList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	quals := d.EqualsQuals
+	fullName := quals["repository_full_name"].GetStringValue()
+	path := quals["path"].GetStringValue()
+	ref := "HEAD"
+	if quals["ref"] != nil && quals["ref"].GetStringValue() != "" {
+		ref = quals["ref"].GetStringValue()
+	}
+	owner, repo := parseRepoFullName(fullName)
+
+	var query struct {
+		RateLimit  models.RateLimit
+		Repository struct {
+			Object struct {
+				Commit struct {
+					Blame struct {
+						Ranges []models.BlameRange
+					} `graphql:"blame(path: $path)"`
+				} `graphql:"... on Commit"`
+			} `graphql:"object(expression: $ref)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner": githubv4.String(owner),
+		"repo":  githubv4.String(repo),
+		"ref":   githubv4.String(ref),
+		"path":  githubv4.String(path),
+	}
+
+	client := connectV4(ctx, d)
+	err := client.Query(ctx, &query, variables)
+	plugin.Logger(ctx).Debug(rateLimitLogString("github_blame", &query.RateLimit))
+	if err != nil {
+		plugin.Logger(ctx).Error("github_blame", "api_error", err)
+		return nil, err
+	}
+
+	for _, r := range query.Repository.Object.Commit.Blame.Ranges {
+		d.StreamListItem(ctx, r)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -2,6 +2,9 @@ package github
 
 import (
 	"context"
+	"maps"
+	"slices"
+
 	"github.com/shurcooL/githubv4"
 	"github.com/turbot/steampipe-plugin-github/github/models"
 
@@ -16,73 +19,134 @@ func tableGitHubBranch() *plugin.Table {
 		Description: "Branches in the given repository.",
 		List: &plugin.ListConfig{
 			KeyColumns: []*plugin.KeyColumn{
-				{Name: "repository_full_name", Require: plugin.Required},
+				{Name: "repository_full_name", Require: plugin.Optional, Operators: []string{"=", "~~"}},
 			},
 			ShouldIgnoreError: isNotFoundError([]string{"404"}),
 			Hydrate:           tableGitHubBranchList,
 		},
 		Columns: []*plugin.Column{
-			{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromQual("repository_full_name"), Description: "Full name of the repository that contains the branch."},
+			{Name: "repository_full_name", Type: proto.ColumnType_STRING, Description: "Full name of the repository that contains the branch."},
 			{Name: "name", Type: proto.ColumnType_STRING, Description: "Name of the branch.", Transform: transform.FromField("Node.Name")},
 			{Name: "commit", Type: proto.ColumnType_JSON, Transform: transform.FromField("Node.Target.Commit"), Description: "Latest commit on the branch."},
 			{Name: "protected", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Node.BranchProtectionRule.NodeId").Transform(HasValue), Description: "If true, the branch is protected."},
 			{Name: "branch_protection_rule", Type: proto.ColumnType_JSON, Transform: transform.FromField("Node.BranchProtectionRule").NullIfZero(), Description: "Branch protection rule if protected."},
+			{Name: "commit_sha", Type: proto.ColumnType_STRING, Transform: transform.FromField("Node.Target.Commit.Sha"), Description: "SHA of the latest commit on the branch."},
+			{Name: "commit_message", Type: proto.ColumnType_STRING, Transform: transform.FromField("Node.Target.Commit.Message"), Description: "Message of the latest commit on the branch."},
+			{Name: "commit_author_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Node.Target.Commit.Author.Name"), Description: "Name of the author of the latest commit on the branch."},
+			{Name: "commit_author_login", Type: proto.ColumnType_STRING, Transform: transform.FromField("Node.Target.Commit.Author.User.Login").NullIfZero(), Description: "Login of the GitHub user who authored the latest commit on the branch, if any."},
+			{Name: "commit_authored_date", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Node.Target.Commit.AuthoredDate").NullIfZero().Transform(convertTimestamp), Description: "Timestamp when the latest commit on the branch was authored."},
+			{Name: "commit_committed_date", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Node.Target.Commit.CommittedDate").NullIfZero().Transform(convertTimestamp), Description: "Timestamp when the latest commit on the branch was committed."},
+			{Name: "ahead_by", Type: proto.ColumnType_INT, Transform: transform.FromField("Node.Compare.BehindBy"), Description: "Number of commits on the branch which are not on the default branch of the repository."},
+			{Name: "behind_by", Type: proto.ColumnType_INT, Transform: transform.FromField("Node.Compare.AheadBy"), Description: "Number of commits on the default branch of the repository which are not on the branch."},
 		},
 	}
 }
 
+// branchRow is a branch of the repository it was listed from
+type branchRow struct {
+	RepositoryFullName string
+	Node               models.Branch
+}
+
 func tableGitHubBranchList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	client := connectV4(ctx, d)
 
-	fullName := d.EqualsQuals["repository_full_name"].GetStringValue()
-	owner, repo := parseRepoFullName(fullName)
+	fullNames, err := repositoryFullNamesFromQuals(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("github_branch", "invalid filter", "repository_full_name", err)
+		return nil, err
+	}
 
 	pageSize := adjustPageSize(100, d.QueryContext.Limit)
 
-	var query struct {
-		RateLimit  models.RateLimit
-		Repository struct {
-			Refs struct {
-				TotalCount int
-				PageInfo   models.PageInfo
-				Edges      []struct {
-					Node models.Branch
-				}
-			} `graphql:"refs(refPrefix: \"refs/heads/\", first: $pageSize, after: $cursor)"`
-		} `graphql:"repository(owner: $owner, name: $repo)"`
-	}
-
-	variables := map[string]interface{}{
-		"owner":    githubv4.String(owner),
-		"repo":     githubv4.String(repo),
+	baseVariables := map[string]interface{}{
 		"pageSize": githubv4.Int(pageSize),
-		"cursor":   (*githubv4.String)(nil),
 	}
+	appendCommitColumnIncludes(&baseVariables, d.QueryContext.Columns)
+	includeCompare := slices.Contains(d.QueryContext.Columns, "ahead_by") || slices.Contains(d.QueryContext.Columns, "behind_by")
+	baseVariables["includeBranchCompare"] = githubv4.Boolean(includeCompare)
+	baseVariables["defaultBranchName"] = githubv4.String("")
 
-	for {
-		err := client.Query(ctx, &query, variables)
-		plugin.Logger(ctx).Debug(rateLimitLogString("github_branch", &query.RateLimit))
-		if err != nil {
-			plugin.Logger(ctx).Error("github_branch", "api_error", err)
-			return nil, err
+	listRepositoryBranches := func(ctx context.Context, fullName string) error {
+		var query struct {
+			RateLimit  models.RateLimit
+			Repository struct {
+				Refs struct {
+					TotalCount int
+					PageInfo   models.PageInfo
+					Edges      []struct {
+						Node models.Branch
+					}
+				} `graphql:"refs(refPrefix: \"refs/heads/\", first: $pageSize, after: $cursor)"`
+			} `graphql:"repository(owner: $owner, name: $repo)"`
 		}
 
-		for _, branch := range query.Repository.Refs.Edges {
-			d.StreamListItem(ctx, branch)
+		owner, repo := parseRepoFullName(fullName)
+		variables := maps.Clone(baseVariables)
+		variables["owner"] = githubv4.String(owner)
+		variables["repo"] = githubv4.String(repo)
+		variables["cursor"] = (*githubv4.String)(nil)
 
-			// Context can be cancelled due to manual cancellation or the limit has been hit
-			if d.RowsRemaining(ctx) == 0 {
-				return nil, nil
+		// The branches are compared to the default branch, whose name must be
+		// known before they are listed
+		if includeCompare {
+			defaultBranchName, err := getDefaultBranchName(ctx, client, owner, repo)
+			if err != nil {
+				plugin.Logger(ctx).Error("github_branch", "api_error", err)
+				return err
 			}
+			variables["defaultBranchName"] = githubv4.String(defaultBranchName)
 		}
 
-		if !query.Repository.Refs.PageInfo.HasNextPage {
-			break
+		for {
+			err := client.Query(ctx, &query, variables)
+			plugin.Logger(ctx).Debug(rateLimitLogString("github_branch", &query.RateLimit))
+			if err != nil {
+				plugin.Logger(ctx).Error("github_branch", "api_error", err)
+				return err
+			}
+
+			for _, branch := range query.Repository.Refs.Edges {
+				d.StreamListItem(ctx, branchRow{RepositoryFullName: fullName, Node: branch.Node})
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil
+				}
+			}
+
+			if !query.Repository.Refs.PageInfo.HasNextPage {
+				return nil
+			}
+			variables["cursor"] = githubv4.NewString(query.Repository.Refs.PageInfo.EndCursor)
 		}
-		variables["cursor"] = githubv4.NewString(query.Repository.Refs.PageInfo.EndCursor)
 	}
 
-	return nil, nil
+	return nil, forEachRepository(ctx, d, fullNames, listRepositoryBranches)
+}
+
+func getDefaultBranchName(ctx context.Context, client *githubv4.Client, owner string, repo string) (string, error) {
+	var query struct {
+		RateLimit  models.RateLimit
+		Repository struct {
+			DefaultBranchRef struct {
+				Name string
+			}
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner": githubv4.String(owner),
+		"repo":  githubv4.String(repo),
+	}
+
+	err := client.Query(ctx, &query, variables)
+	plugin.Logger(ctx).Debug(rateLimitLogString("github_branch", &query.RateLimit))
+	if err != nil {
+		return "", err
+	}
+
+	return query.Repository.DefaultBranchRef.Name, nil
 }
 
 // HasValue Note: if useful to other tables, move to utils.go
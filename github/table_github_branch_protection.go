@@ -46,6 +46,7 @@ func tableGitHubBranchProtection() *plugin.Table {
 			{Name: "requires_linear_history", Type: proto.ColumnType_BOOL, Description: "If true, prevent merge commits from being pushed to matching branches."},
 			{Name: "requires_status_checks", Type: proto.ColumnType_BOOL, Description: "If true, status checks are required to update matching branches."},
 			{Name: "required_status_checks", Type: proto.ColumnType_JSON, Description: "Status checks that must pass before a branch can be merged into branches matching this rule."},
+			{Name: "required_status_check_sources", Type: proto.ColumnType_JSON, Description: "Status checks that must pass before a branch can be merged, along with the app that must provide each check. The app is empty if any source is allowed."},
 			{Name: "requires_strict_status_checks", Type: proto.ColumnType_BOOL, Description: "If true, branches required to be up to date before merging."},
 			{Name: "restricts_review_dismissals", Type: proto.ColumnType_BOOL, Description: "If true, review dismissals are restricted."},
 			{Name: "restricts_pushes", Type: proto.ColumnType_BOOL, Description: "If true, pushing to matching branches is restricted."},
@@ -314,6 +315,7 @@ func mapBranchProtectionRule(rule *models.BranchProtectionRuleWithFirstPageEmbed
 		RequiredApprovingReviewCount:   rule.RequiredApprovingReviewCount,
 		RequiredDeploymentEnvironments: rule.RequiredDeploymentEnvironments,
 		RequiredStatusChecks:           rule.RequiredStatusChecks,
+		RequiredStatusCheckSources:     rule.RequiredStatusCheckSources,
 		RequiresApprovingReviews:       rule.RequiresApprovingReviews,
 		RequiresConversationResolution: rule.RequiresConversationResolution,
 		RequiresCodeOwnerReviews:       rule.RequiresCodeOwnerReviews,
@@ -323,6 +325,7 @@ func mapBranchProtectionRule(rule *models.BranchProtectionRuleWithFirstPageEmbed
 		RequiresStatusChecks:           rule.RequiresStatusChecks,
 		RequiresStrictStatusChecks:     rule.RequiresStrictStatusChecks,
 		RestrictsPushes:                rule.RestrictsPushes,
+		RestrictsReviewDismissals:      rule.RestrictsReviewDismissals,
 	}
 
 	row.PushAllowanceApps, row.PushAllowanceTeams, row.PushAllowanceUsers = rule.PushAllowances.Explode()
@@ -350,6 +353,7 @@ type branchProtectionRow struct {
 	RequiredApprovingReviewCount    int
 	RequiredDeploymentEnvironments  []string
 	RequiredStatusChecks            []string
+	RequiredStatusCheckSources      []models.RequiredStatusCheck
 	RequiresApprovingReviews        bool
 	RequiresConversationResolution  bool
 	RequiresCodeOwnerReviews        bool
@@ -359,6 +363,7 @@ type branchProtectionRow struct {
 	RequiresStatusChecks            bool
 	RequiresStrictStatusChecks      bool
 	RestrictsPushes                 bool
+	RestrictsReviewDismissals       bool
 	PushAllowanceApps               []models.NameSlug
 	PushAllowanceTeams              []models.NameSlug
 	PushAllowanceUsers              []models.NameLogin
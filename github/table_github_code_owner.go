@@ -15,9 +15,11 @@ func gitHubCodeOwnerColumns() []*plugin.Column {
 	return []*plugin.Column{
 		// Top columns
 		{Name: "repository_full_name", Type: proto.ColumnType_STRING, Description: "The full name of the repository, including the owner and repo name."},
+		{Name: "path", Type: proto.ColumnType_STRING, Description: "The path of the CODEOWNERS file the rule is defined in."},
 		// Other columns
 		{Name: "line", Type: proto.ColumnType_INT, Description: "The rule's line number in the CODEOWNERS file.", Transform: transform.FromField("LineNumber")},
 		{Name: "pattern", Type: proto.ColumnType_STRING, Description: "The pattern used to identify what code a team, or an individual is responsible for"},
+		{Name: "owners", Type: proto.ColumnType_JSON, Description: "Users, teams and email addresses responsible for code matching the pattern, in the order listed. Empty if the rule removes ownership."},
 		{Name: "users", Type: proto.ColumnType_JSON, Description: "Users responsible for code in the repo"},
 		{Name: "teams", Type: proto.ColumnType_JSON, Description: "Teams responsible for code in the repo"},
 		{Name: "pre_comments", Type: proto.ColumnType_JSON, Description: "Specifies the comments added above a key."},
@@ -39,8 +41,10 @@ func tableGitHubCodeOwner() *plugin.Table {
 }
 
 type CodeOwnerRule struct {
+	Path        string
 	LineNumber  int
 	Pattern     string
+	Owners      []string
 	Users       []string
 	Teams       []string
 	PreComments []string
@@ -54,8 +58,10 @@ func tableGitHubCodeOwnerList(ctx context.Context, d *plugin.QueryData, h *plugi
 
 	type CodeOwnerRuleResponse struct {
 		RepositoryFullName string
+		Path               string
 		LineNumber         int
 		Pattern            string
+		Owners             []string
 		Users              []string
 		Teams              []string
 		PreComments        []string
@@ -64,6 +70,7 @@ func tableGitHubCodeOwnerList(ctx context.Context, d *plugin.QueryData, h *plugi
 
 	getCodeOwners := func(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 		var fileContent *github.RepositoryContent
+		var filePath string
 		var err error
 
 		client := connect(ctx, d)
@@ -75,6 +82,7 @@ func tableGitHubCodeOwnerList(ctx context.Context, d *plugin.QueryData, h *plugi
 			fileContent, _, _, err = client.Repositories.GetContents(ctx, owner, repoName, path, opt)
 			// Stop on the first CODEOWNERS file found
 			if err == nil {
+				filePath = path
 				break
 			}
 			// HTTP 404 is the only tolerated HTTP error code, if it's different, it
@@ -96,7 +104,11 @@ func tableGitHubCodeOwnerList(ctx context.Context, d *plugin.QueryData, h *plugi
 			return []*CodeOwnerRule{}, err
 		}
 
-		return decodeCodeOwnerFileContent(decodedContent), err
+		rules := decodeCodeOwnerFileContent(decodedContent)
+		for _, rule := range rules {
+			rule.Path = filePath
+		}
+		return rules, nil
 	}
 
 	codeOwnersElements, err := plugin.RetryHydrate(ctx, d, h, getCodeOwners, retryConfig())
@@ -109,8 +121,10 @@ func tableGitHubCodeOwnerList(ctx context.Context, d *plugin.QueryData, h *plugi
 		if codeOwner != nil {
 			d.StreamListItem(ctx, CodeOwnerRuleResponse{
 				RepositoryFullName: repoFullName,
+				Path:               codeOwner.Path,
 				LineNumber:         codeOwner.LineNumber,
 				Pattern:            codeOwner.Pattern,
+				Owners:             codeOwner.Owners,
 				Users:              codeOwner.Users,
 				Teams:              codeOwner.Teams,
 				PreComments:        codeOwner.PreComments,
@@ -127,6 +141,7 @@ func decodeCodeOwnerFileContent(content string) []*CodeOwnerRule {
 	var comments []string
 	for i, line := range strings.Split(content, "\n") {
 		lineNumber := i + 1
+		line = strings.TrimSpace(line)
 		// if line is empty, consider the codeblock end
 		if len(line) == 0 {
 			comments = []string{}
@@ -137,35 +152,40 @@ func decodeCodeOwnerFileContent(content string) []*CodeOwnerRule {
 			comments = append(comments, line)
 			continue
 		}
-		// code owners rule
-		// if line is empty, consider the codeblock end
-		rule := strings.SplitN(line, " ", 2)
-		if len(rule) < 2 {
-			comments = []string{}
-			continue
-		}
-
-		var pattern, lineComment string
-		pattern = rule[0]
 
 		// line comment
-		ownersAndComment := strings.SplitN(rule[1], "#", 2)
-		if len(ownersAndComment) == 2 && len(ownersAndComment[1]) > 0 {
-			lineComment = ownersAndComment[1]
-		} else {
-			ownersAndComment = []string{rule[1]}
+		rule, lineComment := splitCodeOwnerLineComment(line)
+
+		// the pattern and its owners may be separated by any amount of whitespace,
+		// a pattern without owners removes the ownership of the matching files
+		fields := strings.Fields(rule)
+		if len(fields) == 0 {
+			continue
 		}
+		pattern := fields[0]
 
 		// owners computing
-		var users, teams []string
-		for _, owner := range strings.Split(strings.TrimSpace(ownersAndComment[0]), " ") {
+		var owners, users, teams []string
+		for _, owner := range fields[1:] {
+			owners = append(owners, owner)
 			if strings.Index(owner, "/") > 0 {
 				teams = append(teams, owner)
 			} else {
 				users = append(users, owner)
 			}
 		}
-		codeOwnerRules = append(codeOwnerRules, &CodeOwnerRule{LineNumber: lineNumber, Pattern: pattern, Users: users, Teams: teams, PreComments: comments, LineComment: lineComment})
+		codeOwnerRules = append(codeOwnerRules, &CodeOwnerRule{LineNumber: lineNumber, Pattern: pattern, Owners: owners, Users: users, Teams: teams, PreComments: comments, LineComment: lineComment})
 	}
 	return codeOwnerRules
 }
+
+// splitCodeOwnerLineComment splits a rule from its trailing comment, which starts at a # following whitespace. An
+// escaped \# is part of the pattern.
+func splitCodeOwnerLineComment(line string) (string, string) {
+	for i := 1; i < len(line); i++ {
+		if line[i] == '#' && (line[i-1] == ' ' || line[i-1] == '\t') {
+			return line[:i], line[i+1:]
+		}
+	}
+	return line, ""
+}
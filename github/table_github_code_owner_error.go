@@ -0,0 +1,60 @@
+package github
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubCodeOwnerError() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_code_owner_error",
+		Description: "Errors detected by GitHub in the CODEOWNERS file of a repository, such as syntax errors and unknown owners.",
+		List: &plugin.ListConfig{
+			Hydrate:           tableGitHubCodeOwnerErrorList,
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			KeyColumns:        plugin.SingleColumn("repository_full_name"),
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromQual("repository_full_name"), Description: "The full name of the repository, including the owner and repo name."},
+			{Name: "path", Type: proto.ColumnType_STRING, Description: "The path of the CODEOWNERS file containing the error."},
+			{Name: "line", Type: proto.ColumnType_INT, Description: "The line number of the error in the CODEOWNERS file."},
+			{Name: "kind", Type: proto.ColumnType_STRING, Description: "The kind of error, for example Invalid pattern or Unknown owner."},
+			{Name: "message", Type: proto.ColumnType_STRING, Description: "A human-readable description of the error, including the offending line."},
+			// Other columns
+			{Name: "column", Type: proto.ColumnType_INT, Description: "The column number of the error on the line."},
+			{Name: "source", Type: proto.ColumnType_STRING, Description: "The contents of the line containing the error."},
+			{Name: "suggestion", Type: proto.ColumnType_STRING, Description: "A suggested fix for the error, if any."},
+		},
+	}
+}
+
+func tableGitHubCodeOwnerErrorList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+
+	fullName := d.EqualsQuals["repository_full_name"].GetStringValue()
+	owner, repo := parseRepoFullName(fullName)
+
+	// The response is not paginated, all of the errors are returned at once
+	codeownersErrors, _, err := client.Repositories.GetCodeownersErrors(ctx, owner, repo)
+	if err != nil {
+		plugin.Logger(ctx).Error("github_code_owner_error", "api_error", err)
+		return nil, err
+	}
+
+	for _, e := range codeownersErrors.Errors {
+		if e != nil {
+			d.StreamListItem(ctx, e)
+		}
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/turbot/steampipe-plugin-github/github/models"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"strings"
 	"time"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
@@ -20,6 +21,8 @@ func tableGitHubCommit() *plugin.Table {
 			KeyColumns: []*plugin.KeyColumn{
 				{Name: "repository_full_name", Require: plugin.Required},
 				{Name: "authored_date", Require: plugin.Optional, Operators: []string{">", ">=", "=", "<", "<="}},
+				{Name: "author_login", Require: plugin.Optional},
+				{Name: "path", Require: plugin.Optional},
 			},
 			Hydrate: tableGitHubCommitList,
 		},
@@ -45,6 +48,15 @@ func tableGitHubCommit() *plugin.Table {
 			{Name: "committed_via_web", Type: proto.ColumnType_BOOL, Description: "If true, commit was made via GitHub web ui."},
 			{Name: "commit_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("CommitUrl"), Description: "URL of the commit."},
 			{Name: "signature", Type: proto.ColumnType_JSON, Transform: transform.FromField("Signature").NullIfZero(), Description: "The signature of commit."},
+			{Name: "signature_verified", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Signature.IsValid"), Description: "If true, the commit is signed and its signature was verified by GitHub."},
+			{Name: "signature_reason", Type: proto.ColumnType_STRING, Transform: transform.FromField("Signature.State").NullIfZero(), Description: "The verification state of the signature, e.g. VALID, UNSIGNED, UNKNOWN_KEY or BAD_EMAIL."},
+			{Name: "signature_type", Type: proto.ColumnType_STRING, Transform: transform.FromField("Signature.Type").NullIfZero().Transform(commitSignatureType), Description: "The type of the signature, one of gpg, smime, ssh or unknown, or null if the commit is unsigned."},
+			{Name: "signer_login", Type: proto.ColumnType_STRING, Transform: transform.FromField("Signature.Signer.Login").NullIfZero(), Description: "The login of the GitHub user whose key made the signature."},
+			{Name: "signature_email_matches_committer", Type: proto.ColumnType_BOOL, Transform: transform.From(commitSignatureEmailMatchesCommitter), Description: "If true, the email of the signature is the email of the committer."},
+			{Name: "author_email", Type: proto.ColumnType_STRING, Transform: transform.FromField("Author.Email"), Description: "The email of the author of the commit."},
+			{Name: "committer_email", Type: proto.ColumnType_STRING, Transform: transform.FromField("Committer.Email"), Description: "The email of the committer."},
+			{Name: "authored_by_committer", Type: proto.ColumnType_BOOL, Description: "If true, the author and the committer of the commit are the same."},
+			{Name: "associated_pull_requests", Type: proto.ColumnType_JSON, Transform: transform.FromField("AssociatedPullRequests.Nodes"), Description: "The first 10 pull requests which contain the commit, such as the one it was merged by."},
 			{Name: "status", Type: proto.ColumnType_JSON, Transform: transform.FromField("Status").NullIfZero(), Description: "Status of the commit."},
 			{Name: "tarball_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("TarballUrl"), Description: "URL to download a tar of commit."},
 			{Name: "zipball_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("ZipballUrl"), Description: "URL to download a zip of commit."},
@@ -53,6 +65,7 @@ func tableGitHubCommit() *plugin.Table {
 			{Name: "subscription", Type: proto.ColumnType_STRING, Description: "Users subscription state of the commit."},
 			{Name: "url", Type: proto.ColumnType_STRING, Transform: transform.FromField("Url"), Description: "URL of the commit."},
 			{Name: "node_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("NodeId"), Description: "The node ID of the commit."},
+			{Name: "path", Type: proto.ColumnType_STRING, Transform: transform.FromQual("path"), Description: "The file or directory path the commit touches, only populated when used as a filter."},
 		},
 	}
 }
@@ -69,6 +82,29 @@ func tableGitHubCommitList(ctx context.Context, d *plugin.QueryData, h *plugin.H
 		"cursor":   (*githubv4.String)(nil),
 		"since":    (*githubv4.GitTimestamp)(nil),
 		"until":    (*githubv4.GitTimestamp)(nil),
+		"path":     (*githubv4.String)(nil),
+		"author":   (*githubv4.CommitAuthor)(nil),
+	}
+	appendCommitColumnIncludes(&variables, d.QueryContext.Columns)
+
+	if d.EqualsQuals["path"] != nil {
+		variables["path"] = githubv4.NewString(githubv4.String(d.EqualsQuals["path"].GetStringValue()))
+	}
+
+	client := connectV4(ctx, d)
+
+	// The history can only be filtered by the node ID of the author
+	if d.EqualsQuals["author_login"] != nil {
+		authorId, err := getCommitAuthorNodeId(ctx, client, d.EqualsQuals["author_login"].GetStringValue())
+		if err != nil {
+			plugin.Logger(ctx).Error("github_commit", "api_error", err)
+			return nil, err
+		}
+		// No commits can match a login which is not a user
+		if authorId == nil {
+			return nil, nil
+		}
+		variables["author"] = &githubv4.CommitAuthor{ID: &authorId}
 	}
 
 	if d.Quals["authored_date"] != nil {
@@ -103,15 +139,13 @@ func tableGitHubCommitList(ctx context.Context, d *plugin.QueryData, h *plugin.H
 							TotalCount int
 							PageInfo   models.PageInfo
 							Nodes      []models.Commit
-						} `graphql:"history(first: $pageSize, after: $cursor, since: $since, until: $until)"`
+						} `graphql:"history(first: $pageSize, after: $cursor, since: $since, until: $until, path: $path, author: $author)"`
 					} `graphql:"... on Commit"`
 				}
 			}
 		} `graphql:"repository(owner: $owner, name: $name)"`
 	}
 
-	client := connectV4(ctx, d)
-
 	for {
 		err := client.Query(ctx, &query, variables)
 		plugin.Logger(ctx).Debug(rateLimitLogString("github_commit", &query.RateLimit))
@@ -138,6 +172,30 @@ func tableGitHubCommitList(ctx context.Context, d *plugin.QueryData, h *plugin.H
 	return nil, nil
 }
 
+func getCommitAuthorNodeId(ctx context.Context, client *githubv4.Client, login string) (githubv4.ID, error) {
+	var query struct {
+		RateLimit models.RateLimit
+		User      struct {
+			Id githubv4.ID `graphql:"id"`
+		} `graphql:"user(login: $login)"`
+	}
+
+	variables := map[string]interface{}{
+		"login": githubv4.String(login),
+	}
+
+	err := client.Query(ctx, &query, variables)
+	plugin.Logger(ctx).Debug(rateLimitLogString("github_commit", &query.RateLimit))
+	if err != nil {
+		if strings.Contains(err.Error(), "Could not resolve to a User with the login of") {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return query.User.Id, nil
+}
+
 func tableGitHubCommitGet(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	quals := d.EqualsQuals
 	fullName := quals["repository_full_name"].GetStringValue()
@@ -158,6 +216,7 @@ func tableGitHubCommitGet(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 		"name":  githubv4.String(repo),
 		"sha":   githubv4.GitObjectID(sha),
 	}
+	appendCommitColumnIncludes(&variables, d.QueryContext.Columns)
 
 	client := connectV4(ctx, d)
 
@@ -170,3 +229,25 @@ func tableGitHubCommitGet(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 
 	return query.Repository.Object.Commit, nil
 }
+
+func commitSignatureType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	switch d.Value {
+	case "GpgSignature":
+		return "gpg", nil
+	case "SmimeSignature":
+		return "smime", nil
+	case "SshSignature":
+		return "ssh", nil
+	case nil:
+		return nil, nil
+	}
+	return "unknown", nil
+}
+
+func commitSignatureEmailMatchesCommitter(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	commit, ok := d.HydrateItem.(models.Commit)
+	if !ok || commit.Signature.Email == "" {
+		return nil, nil
+	}
+	return strings.EqualFold(commit.Signature.Email, commit.Committer.Email), nil
+}
@@ -0,0 +1,90 @@
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v55/github"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubCommitComparison() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_commit_comparison",
+		Description: "Comparison between two commits, branches or tags in the given repository.",
+		List: &plugin.ListConfig{
+			Hydrate:           tableGitHubCommitComparisonList,
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "repository_full_name", Require: plugin.Required},
+				{Name: "base", Require: plugin.Required},
+				{Name: "head", Require: plugin.Required},
+			},
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromQual("repository_full_name"), Description: "Full name of the repository that contains the compared refs."},
+			{Name: "base", Type: proto.ColumnType_STRING, Transform: transform.FromQual("base"), Description: "The base ref (commit SHA, branch or tag) of the comparison."},
+			{Name: "head", Type: proto.ColumnType_STRING, Transform: transform.FromQual("head"), Description: "The head ref (commit SHA, branch or tag) of the comparison."},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "The status of head compared to base, one of diverged, ahead, behind or identical."},
+			{Name: "ahead_by", Type: proto.ColumnType_INT, Description: "Number of commits head is ahead of base."},
+			{Name: "behind_by", Type: proto.ColumnType_INT, Description: "Number of commits head is behind base."},
+			{Name: "total_commits", Type: proto.ColumnType_INT, Description: "Total number of commits between base and head."},
+			// Other columns
+			{Name: "merge_base_commit_sha", Type: proto.ColumnType_STRING, Transform: transform.FromField("MergeBaseCommit.SHA"), Description: "SHA of the best common ancestor of base and head."},
+			{Name: "base_commit_sha", Type: proto.ColumnType_STRING, Transform: transform.FromField("BaseCommit.SHA"), Description: "SHA of the commit the base ref resolves to."},
+			{Name: "merge_base_commit", Type: proto.ColumnType_JSON, Transform: transform.FromField("MergeBaseCommit").NullIfZero(), Description: "The best common ancestor commit of base and head."},
+			{Name: "base_commit", Type: proto.ColumnType_JSON, Transform: transform.FromField("BaseCommit").NullIfZero(), Description: "The commit the base ref resolves to."},
+			{Name: "commits", Type: proto.ColumnType_JSON, Transform: transform.FromField("Commits").NullIfZero(), Description: "The commits reachable from head but not from base."},
+			{Name: "files", Type: proto.ColumnType_JSON, Transform: transform.FromField("Files").NullIfZero(), Description: "The files changed between base and head (at most 300 files are returned by the API)."},
+			{Name: "html_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("HTMLURL"), Description: "The URL of the comparison in the GitHub UI."},
+			{Name: "permalink_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("PermalinkURL"), Description: "The permanent URL of the comparison."},
+			{Name: "diff_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("DiffURL"), Description: "The URL to download the diff for the comparison."},
+			{Name: "patch_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("PatchURL"), Description: "The URL to download the patch for the comparison."},
+			{Name: "url", Type: proto.ColumnType_STRING, Transform: transform.FromField("URL"), Description: "The API URL of the comparison."},
+		},
+	}
+}
+
+func tableGitHubCommitComparisonList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	client := connect(ctx, d)
+
+	quals := d.EqualsQuals
+	fullName := quals["repository_full_name"].GetStringValue()
+	base := quals["base"].GetStringValue()
+	head := quals["head"].GetStringValue()
+	owner, repo := parseRepoFullName(fullName)
+
+	opts := &github.ListOptions{PerPage: 100}
+
+	// The commits in a comparison are paginated, the remaining fields are
+	// identical across pages so only the commits are accumulated
+	var comparison *github.CommitsComparison
+	for {
+		page, resp, err := client.Repositories.CompareCommits(ctx, owner, repo, base, head, opts)
+		if err != nil {
+			logger.Error("github_commit_comparison.tableGitHubCommitComparisonList", "api_error", err)
+			return nil, err
+		}
+
+		if comparison == nil {
+			comparison = page
+		} else {
+			comparison.Commits = append(comparison.Commits, page.Commits...)
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	if comparison != nil {
+		d.StreamListItem(ctx, comparison)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,64 @@
+package github
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubEnterpriseAuditLog() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_enterprise_audit_log",
+		Description: "Gets the audit logs for an enterprise, covering all of its organizations.",
+		List: &plugin.ListConfig{
+			KeyColumns: append([]*plugin.KeyColumn{
+				{Name: "enterprise", Require: plugin.Required},
+				{Name: "org", Require: plugin.Optional},
+			}, gitHubAuditLogKeyColumns()...),
+			Hydrate: tableGitHubEnterpriseAuditLogList,
+		},
+		Columns: append([]*plugin.Column{
+			{Name: "enterprise", Type: proto.ColumnType_STRING, Transform: transform.FromQual("enterprise"), Description: "The slug of the GitHub enterprise."},
+			{Name: "org", Type: proto.ColumnType_STRING, Description: "The organization the audit event relates to, if any."},
+		}, gitHubAuditLogColumns()...),
+	}
+}
+
+func tableGitHubEnterpriseAuditLogList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	enterprise := d.EqualsQuals["enterprise"].GetStringValue()
+	opts := buildAuditLogOptions(d)
+
+	if d.EqualsQuals["org"] != nil {
+		phrase := *opts.Phrase + " org:" + d.EqualsQuals["org"].GetStringValue()
+		opts.Phrase = &phrase
+	}
+
+	client := connect(ctx, d)
+
+	for {
+		auditResults, resp, err := client.Enterprise.GetAuditLog(ctx, enterprise, opts)
+		if err != nil {
+			plugin.Logger(ctx).Error("github_enterprise_audit_log", "api_error", err)
+			return nil, err
+		}
+
+		for _, i := range auditResults {
+			d.StreamListItem(ctx, i)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if resp.After == "" {
+			break
+		}
+
+		opts.After = resp.After
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,97 @@
+package github
+
+import (
+	"context"
+	"strings"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/turbot/steampipe-plugin-github/github/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubEnterpriseIpAllowList() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_enterprise_ip_allow_list",
+		Description: "IP allow list entries for the given enterprise.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.SingleColumn("enterprise"),
+			Hydrate:    tableGitHubEnterpriseIpAllowListList,
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "enterprise", Type: proto.ColumnType_STRING, Transform: transform.FromQual("enterprise"), Description: "The slug of the enterprise the IP allow list entry belongs to."},
+			{Name: "allow_list_value", Type: proto.ColumnType_CIDR, Description: "A single IP address or range of IP addresses in CIDR notation."},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the IP allow list entry."},
+			{Name: "is_active", Type: proto.ColumnType_BOOL, Description: "If true, the entry is currently active."},
+			// Other columns
+			{Name: "node_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("NodeId"), Description: "The node ID of the IP allow list entry."},
+			{Name: "ip_allow_list_enabled", Type: proto.ColumnType_BOOL, Transform: transform.FromField("IpAllowListEnabledSetting").Transform(isIpAllowListSettingEnabled), Description: "If true, the IP allow list is enforced for the enterprise."},
+			{Name: "ip_allow_list_for_installed_apps_enabled", Type: proto.ColumnType_BOOL, Transform: transform.FromField("IpAllowListForInstalledAppsEnabledSetting").Transform(isIpAllowListSettingEnabled), Description: "If true, the IP allow list configuration of installed GitHub Apps is applied to the enterprise."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("CreatedAt").NullIfZero().Transform(convertTimestamp), Description: "Timestamp when the entry was created."},
+			{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("UpdatedAt").NullIfZero().Transform(convertTimestamp), Description: "Timestamp when the entry was last updated."},
+		},
+	}
+}
+
+func tableGitHubEnterpriseIpAllowListList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	enterprise := d.EqualsQuals["enterprise"].GetStringValue()
+
+	var query struct {
+		RateLimit  models.RateLimit
+		Enterprise struct {
+			OwnerInfo struct {
+				IpAllowListEnabledSetting                 githubv4.IpAllowListEnabledSettingValue
+				IpAllowListForInstalledAppsEnabledSetting githubv4.IpAllowListForInstalledAppsEnabledSettingValue
+				IpAllowListEntries                        struct {
+					PageInfo models.PageInfo
+					Nodes    []models.IpAllowListEntry
+				} `graphql:"ipAllowListEntries(first: $pageSize, after: $cursor)"`
+			}
+		} `graphql:"enterprise(slug: $enterprise)"`
+	}
+
+	pageSize := adjustPageSize(100, d.QueryContext.Limit)
+	variables := map[string]interface{}{
+		"enterprise": githubv4.String(enterprise),
+		"pageSize":   githubv4.Int(pageSize),
+		"cursor":     (*githubv4.String)(nil),
+	}
+
+	client := connectV4(ctx, d)
+	for {
+		err := client.Query(ctx, &query, variables)
+		plugin.Logger(ctx).Debug(rateLimitLogString("github_enterprise_ip_allow_list", &query.RateLimit))
+		if err != nil {
+			plugin.Logger(ctx).Error("github_enterprise_ip_allow_list", "api_error", err)
+			if strings.Contains(err.Error(), "Could not resolve to an Enterprise with the slug of") {
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		ownerInfo := query.Enterprise.OwnerInfo
+		for _, entry := range ownerInfo.IpAllowListEntries.Nodes {
+			// The entries share the row type of the organization allow list
+			d.StreamListItem(ctx, organizationIpAllowListEntry{
+				IpAllowListEntry:                          entry,
+				IpAllowListEnabledSetting:                 ownerInfo.IpAllowListEnabledSetting,
+				IpAllowListForInstalledAppsEnabledSetting: ownerInfo.IpAllowListForInstalledAppsEnabledSetting,
+			})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if !ownerInfo.IpAllowListEntries.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(ownerInfo.IpAllowListEntries.PageInfo.EndCursor)
+	}
+
+	return nil, nil
+}
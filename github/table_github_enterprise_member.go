@@ -0,0 +1,216 @@
+package github
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/turbot/steampipe-plugin-github/github/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type enterpriseMember struct {
+	Type           string
+	Login          string
+	Name           string
+	NodeId         string
+	Url            string
+	CreatedAt      models.NullableTime
+	EnterpriseRole string
+	Organizations  []enterpriseMemberOrganization
+}
+
+type enterpriseMemberOrganization struct {
+	Login string `json:"login"`
+	Role  string `json:"role,omitempty"`
+}
+
+func tableGitHubEnterpriseMember() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_enterprise_member",
+		Description: "Members of the given enterprise, including their enterprise role and organization memberships.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.SingleColumn("enterprise"),
+			Hydrate:    tableGitHubEnterpriseMemberList,
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "enterprise", Type: proto.ColumnType_STRING, Transform: transform.FromQual("enterprise"), Description: "The slug of the enterprise."},
+			{Name: "login", Type: proto.ColumnType_STRING, Description: "The login of the member."},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The display name of the member."},
+			{Name: "enterprise_role", Type: proto.ColumnType_STRING, Transform: transform.FromField("EnterpriseRole").NullIfZero(), Description: "The role of the member in the enterprise, either OWNER or MEMBER. Only available to enterprise owners."},
+			{Name: "organizations", Type: proto.ColumnType_JSON, Transform: transform.FromField("Organizations").NullIfZero(), Description: "The organizations of the enterprise the member belongs to, with the role of the member in each organization where available."},
+			// Other columns
+			{Name: "type", Type: proto.ColumnType_STRING, Description: "The type of the member, either User or EnterpriseUserAccount for managed and server accounts."},
+			{Name: "node_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("NodeId"), Description: "The node ID of the member."},
+			{Name: "url", Type: proto.ColumnType_STRING, Transform: transform.FromField("Url"), Description: "The URL of the member."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("CreatedAt").NullIfZero().Transform(convertTimestamp), Description: "Timestamp when the member account was created."},
+		},
+	}
+}
+
+func tableGitHubEnterpriseMemberList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	enterprise := d.EqualsQuals["enterprise"].GetStringValue()
+	includeOrganizations := slices.Contains(d.QueryContext.Columns, "organizations")
+
+	// The enterprise role is derived from the list of enterprise owners, which
+	// is only fetched if required
+	var owners []string
+	includeEnterpriseRole := slices.Contains(d.QueryContext.Columns, "enterprise_role")
+	if includeEnterpriseRole {
+		var err error
+		owners, err = listEnterpriseOwnerLogins(ctx, d, enterprise)
+		if err != nil {
+			plugin.Logger(ctx).Error("github_enterprise_member", "api_error", err)
+			return nil, err
+		}
+	}
+
+	// The organizations of a user may include organizations outside of the
+	// enterprise, so they are restricted to those of the enterprise
+	var enterpriseOrgs []string
+	if includeOrganizations {
+		var err error
+		enterpriseOrgs, err = listEnterpriseOrganizationLogins(ctx, d, enterprise)
+		if err != nil {
+			plugin.Logger(ctx).Error("github_enterprise_member", "api_error", err)
+			return nil, err
+		}
+	}
+
+	var query struct {
+		RateLimit  models.RateLimit
+		Enterprise struct {
+			Members struct {
+				PageInfo models.PageInfo
+				Nodes    []models.EnterpriseMember
+			} `graphql:"members(first: $pageSize, after: $cursor)"`
+		} `graphql:"enterprise(slug: $enterprise)"`
+	}
+
+	pageSize := adjustPageSize(100, d.QueryContext.Limit)
+	variables := map[string]interface{}{
+		"enterprise":           githubv4.String(enterprise),
+		"pageSize":             githubv4.Int(pageSize),
+		"cursor":               (*githubv4.String)(nil),
+		"includeOrganizations": githubv4.Boolean(includeOrganizations),
+	}
+
+	client := connectV4(ctx, d)
+	for {
+		err := client.Query(ctx, &query, variables)
+		plugin.Logger(ctx).Debug(rateLimitLogString("github_enterprise_member", &query.RateLimit))
+		if err != nil {
+			plugin.Logger(ctx).Error("github_enterprise_member", "api_error", err)
+			if strings.Contains(err.Error(), "Could not resolve to an Enterprise with the slug of") {
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		for _, node := range query.Enterprise.Members.Nodes {
+			member := newEnterpriseMember(node, enterpriseOrgs)
+			if includeEnterpriseRole {
+				member.EnterpriseRole = "MEMBER"
+				if slices.Contains(owners, member.Login) {
+					member.EnterpriseRole = "OWNER"
+				}
+			}
+			d.StreamListItem(ctx, member)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if !query.Enterprise.Members.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Enterprise.Members.PageInfo.EndCursor)
+	}
+
+	return nil, nil
+}
+
+// newEnterpriseMember flattens the user or enterprise user account fragment of the member
+func newEnterpriseMember(node models.EnterpriseMember, enterpriseOrgs []string) enterpriseMember {
+	if node.Type == "EnterpriseUserAccount" {
+		account := node.EnterpriseUserAccount
+		member := enterpriseMember{
+			Type:      node.Type,
+			Login:     account.Login,
+			Name:      account.Name,
+			NodeId:    account.NodeId,
+			Url:       account.Url,
+			CreatedAt: account.CreatedAt,
+		}
+		for _, edge := range account.Organizations.Edges {
+			member.Organizations = append(member.Organizations, enterpriseMemberOrganization{Login: edge.Node.Login, Role: edge.Role})
+		}
+		return member
+	}
+
+	user := node.User
+	member := enterpriseMember{
+		Type:      node.Type,
+		Login:     user.Login,
+		Name:      user.Name,
+		NodeId:    user.NodeId,
+		Url:       user.Url,
+		CreatedAt: user.CreatedAt,
+	}
+	for _, org := range user.Organizations.Nodes {
+		if slices.Contains(enterpriseOrgs, org.Login) {
+			member.Organizations = append(member.Organizations, enterpriseMemberOrganization{Login: org.Login})
+		}
+	}
+	return member
+}
+
+// listEnterpriseOwnerLogins returns the logins of the owners of the given enterprise
+func listEnterpriseOwnerLogins(ctx context.Context, d *plugin.QueryData, enterprise string) ([]string, error) {
+	var query struct {
+		RateLimit  models.RateLimit
+		Enterprise struct {
+			OwnerInfo struct {
+				Admins struct {
+					PageInfo models.PageInfo
+					Nodes    []struct {
+						Login string
+					}
+				} `graphql:"admins(first: 100, after: $cursor)"`
+			}
+		} `graphql:"enterprise(slug: $enterprise)"`
+	}
+
+	variables := map[string]interface{}{
+		"enterprise": githubv4.String(enterprise),
+		"cursor":     (*githubv4.String)(nil),
+	}
+
+	var logins []string
+	client := connectV4(ctx, d)
+	for {
+		err := client.Query(ctx, &query, variables)
+		plugin.Logger(ctx).Debug(rateLimitLogString("github_enterprise_member.listEnterpriseOwnerLogins", &query.RateLimit))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, admin := range query.Enterprise.OwnerInfo.Admins.Nodes {
+			logins = append(logins, admin.Login)
+		}
+
+		if !query.Enterprise.OwnerInfo.Admins.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Enterprise.OwnerInfo.Admins.PageInfo.EndCursor)
+	}
+
+	return logins, nil
+}
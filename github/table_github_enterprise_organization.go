@@ -0,0 +1,129 @@
+package github
+
+import (
+	"context"
+	"strings"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/turbot/steampipe-plugin-github/github/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubEnterpriseOrganization() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_enterprise_organization",
+		Description: "Organizations that belong to the given enterprise.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.SingleColumn("enterprise"),
+			Hydrate:    tableGitHubEnterpriseOrganizationList,
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "enterprise", Type: proto.ColumnType_STRING, Transform: transform.FromQual("enterprise"), Description: "The slug of the enterprise."},
+			{Name: "login", Type: proto.ColumnType_STRING, Description: "The login of the organization."},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The display name of the organization."},
+			// Other columns
+			{Name: "id", Type: proto.ColumnType_INT, Transform: transform.FromField("Id"), Description: "The ID of the organization."},
+			{Name: "node_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("NodeId"), Description: "The node ID of the organization."},
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "The description of the organization."},
+			{Name: "email", Type: proto.ColumnType_STRING, Description: "The public email address of the organization."},
+			{Name: "url", Type: proto.ColumnType_STRING, Transform: transform.FromField("Url"), Description: "The URL of the organization."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("CreatedAt").NullIfZero().Transform(convertTimestamp), Description: "Timestamp when the organization was created."},
+			{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("UpdatedAt").NullIfZero().Transform(convertTimestamp), Description: "Timestamp when the organization was last updated."},
+		},
+	}
+}
+
+func tableGitHubEnterpriseOrganizationList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	enterprise := d.EqualsQuals["enterprise"].GetStringValue()
+
+	var query struct {
+		RateLimit  models.RateLimit
+		Enterprise struct {
+			Organizations struct {
+				PageInfo models.PageInfo
+				Nodes    []models.BasicOrganization
+			} `graphql:"organizations(first: $pageSize, after: $cursor)"`
+		} `graphql:"enterprise(slug: $enterprise)"`
+	}
+
+	pageSize := adjustPageSize(100, d.QueryContext.Limit)
+	variables := map[string]interface{}{
+		"enterprise": githubv4.String(enterprise),
+		"pageSize":   githubv4.Int(pageSize),
+		"cursor":     (*githubv4.String)(nil),
+	}
+
+	client := connectV4(ctx, d)
+	for {
+		err := client.Query(ctx, &query, variables)
+		plugin.Logger(ctx).Debug(rateLimitLogString("github_enterprise_organization", &query.RateLimit))
+		if err != nil {
+			plugin.Logger(ctx).Error("github_enterprise_organization", "api_error", err)
+			if strings.Contains(err.Error(), "Could not resolve to an Enterprise with the slug of") {
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		for _, org := range query.Enterprise.Organizations.Nodes {
+			d.StreamListItem(ctx, org)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if !query.Enterprise.Organizations.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Enterprise.Organizations.PageInfo.EndCursor)
+	}
+
+	return nil, nil
+}
+
+// listEnterpriseOrganizationLogins returns the logins of all of the organizations in the given enterprise
+func listEnterpriseOrganizationLogins(ctx context.Context, d *plugin.QueryData, enterprise string) ([]string, error) {
+	var query struct {
+		RateLimit  models.RateLimit
+		Enterprise struct {
+			Organizations struct {
+				PageInfo models.PageInfo
+				Nodes    []struct {
+					Login string
+				}
+			} `graphql:"organizations(first: 100, after: $cursor)"`
+		} `graphql:"enterprise(slug: $enterprise)"`
+	}
+
+	variables := map[string]interface{}{
+		"enterprise": githubv4.String(enterprise),
+		"cursor":     (*githubv4.String)(nil),
+	}
+
+	var logins []string
+	client := connectV4(ctx, d)
+	for {
+		err := client.Query(ctx, &query, variables)
+		plugin.Logger(ctx).Debug(rateLimitLogString("github_enterprise_organization.listEnterpriseOrganizationLogins", &query.RateLimit))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, org := range query.Enterprise.Organizations.Nodes {
+			logins = append(logins, org.Login)
+		}
+
+		if !query.Enterprise.Organizations.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Enterprise.Organizations.PageInfo.EndCursor)
+	}
+
+	return logins, nil
+}
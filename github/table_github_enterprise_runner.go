@@ -0,0 +1,105 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v55/github"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubEnterpriseRunner() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_enterprise_runner",
+		Description: "Self-hosted runners registered at the enterprise level, which can run jobs for the organizations of the enterprise.",
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "enterprise", Require: plugin.Required},
+				{Name: "runner_group_id", Require: plugin.Optional},
+			},
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubEnterpriseRunnerList,
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "enterprise", Type: proto.ColumnType_STRING, Transform: transform.FromQual("enterprise"), Description: "The slug of the enterprise."},
+			{Name: "runner_group_id", Type: proto.ColumnType_INT, Transform: transform.FromQual("runner_group_id"), Description: "The ID of the runner group to list the runners of."},
+			{Name: "id", Type: proto.ColumnType_INT, Transform: transform.FromField("ID"), Description: "The unique identifier of the runner."},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the runner."},
+			{Name: "os", Type: proto.ColumnType_STRING, Transform: transform.FromField("OS"), Description: "The operating system of the runner."},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "The status of the runner, either online or offline."},
+			{Name: "busy", Type: proto.ColumnType_BOOL, Description: "Indicates whether the runner is currently in use or not."},
+			{Name: "labels", Type: proto.ColumnType_JSON, Description: "Labels represents a collection of labels attached to each runner."},
+		},
+	}
+}
+
+func tableGitHubEnterpriseRunnerList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+
+	quals := d.EqualsQuals
+	enterprise := quals["enterprise"].GetStringValue()
+
+	opts := &github.ListOptions{PerPage: 100}
+
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit < int64(opts.PerPage) {
+			opts.PerPage = int(*limit)
+		}
+	}
+
+	for {
+		var runners *github.Runners
+		var resp *github.Response
+		var err error
+		if quals["runner_group_id"] != nil {
+			runners, resp, err = listEnterpriseRunnerGroupRunners(ctx, client, enterprise, quals["runner_group_id"].GetInt64Value(), opts)
+		} else {
+			runners, resp, err = client.Enterprise.ListRunners(ctx, enterprise, opts)
+		}
+		if err != nil {
+			plugin.Logger(ctx).Error("github_enterprise_runner", "api_error", err)
+			return nil, err
+		}
+
+		for _, i := range runners.Runners {
+			if i != nil {
+				d.StreamListItem(ctx, i)
+			}
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return nil, nil
+}
+
+// listEnterpriseRunnerGroupRunners returns a page of the runners in an enterprise runner group. The go-github client
+// does not support enterprise runner groups yet, so a raw request is used.
+func listEnterpriseRunnerGroupRunners(ctx context.Context, client *github.Client, enterprise string, groupID int64, opts *github.ListOptions) (*github.Runners, *github.Response, error) {
+	req, err := client.NewRequest("GET", fmt.Sprintf("enterprises/%v/actions/runner-groups/%v/runners?per_page=%v&page=%v", enterprise, groupID, opts.PerPage, opts.Page), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	runners := &github.Runners{}
+	resp, err := client.Do(ctx, req, runners)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return runners, resp, nil
+}
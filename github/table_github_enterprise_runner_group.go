@@ -0,0 +1,81 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v55/github"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubEnterpriseRunnerGroup() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_enterprise_runner_group",
+		Description: "Runner groups of the given enterprise, which control the organizations and workflows that can use enterprise runners.",
+		List: &plugin.ListConfig{
+			KeyColumns:        plugin.SingleColumn("enterprise"),
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubEnterpriseRunnerGroupList,
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "enterprise", Type: proto.ColumnType_STRING, Transform: transform.FromQual("enterprise"), Description: "The slug of the enterprise."},
+			{Name: "id", Type: proto.ColumnType_INT, Transform: transform.FromField("ID"), Description: "The ID of the runner group."},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the runner group."},
+			{Name: "visibility", Type: proto.ColumnType_STRING, Description: "The organizations that can use the runner group, either all or selected."},
+			{Name: "is_default", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Default"), Description: "If true, this is the default runner group of the enterprise."},
+			// Other columns
+			{Name: "allows_public_repositories", Type: proto.ColumnType_BOOL, Description: "If true, public repositories can use the runners of the group."},
+			{Name: "restricted_to_workflows", Type: proto.ColumnType_BOOL, Description: "If true, the runners of the group can only run the selected workflows."},
+			{Name: "selected_workflows", Type: proto.ColumnType_JSON, Description: "The workflows that can use the runner group, if restricted to workflows."},
+			{Name: "workflow_restrictions_read_only", Type: proto.ColumnType_BOOL, Description: "If true, the workflow restrictions cannot be changed by organizations."},
+			{Name: "runners_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("RunnersURL"), Description: "The API URL of the runners of the group."},
+		},
+	}
+}
+
+func tableGitHubEnterpriseRunnerGroupList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+
+	enterprise := d.EqualsQuals["enterprise"].GetStringValue()
+
+	opts := &github.ListOptions{PerPage: 100}
+
+	for {
+		// The go-github client does not support enterprise runner groups yet,
+		// so the groups are listed with raw requests
+		req, err := client.NewRequest("GET", fmt.Sprintf("enterprises/%v/actions/runner-groups?per_page=%v&page=%v", enterprise, opts.PerPage, opts.Page), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		groups := &github.RunnerGroups{}
+		resp, err := client.Do(ctx, req, groups)
+		if err != nil {
+			plugin.Logger(ctx).Error("github_enterprise_runner_group", "api_error", err)
+			return nil, err
+		}
+
+		for _, g := range groups.RunnerGroups {
+			if g != nil {
+				d.StreamListItem(ctx, g)
+			}
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,109 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/google/go-github/v55/github"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type gistFile struct {
+	GistID     string
+	OwnerLogin string
+	github.GistFile
+	Truncated bool
+}
+
+func tableGitHubGistFile() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_gist_file",
+		Description: "Files in the given gist, with their decoded content.",
+		List: &plugin.ListConfig{
+			KeyColumns:        plugin.SingleColumn("gist_id"),
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubGistFileList,
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "gist_id", Type: proto.ColumnType_STRING, Description: "The unique id of the gist the file belongs to."},
+			{Name: "filename", Type: proto.ColumnType_STRING, Description: "The name of the file."},
+			{Name: "language", Type: proto.ColumnType_STRING, Description: "The language of the file, as detected by GitHub."},
+			{Name: "size", Type: proto.ColumnType_INT, Description: "The size of the file in bytes."},
+			{Name: "truncated", Type: proto.ColumnType_BOOL, Description: "If true, the content of the file was truncated by the gist API and has been fetched from raw_url instead."},
+			{Name: "content", Type: proto.ColumnType_STRING, Description: "The content of the file."},
+			// Other columns
+			{Name: "owner_login", Type: proto.ColumnType_STRING, Description: "The user login name of the gist owner."},
+			{Name: "type", Type: proto.ColumnType_STRING, Description: "The MIME type of the file."},
+			{Name: "raw_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("RawURL"), Description: "The URL to download the raw content of the file."},
+		},
+	}
+}
+
+func tableGitHubGistFileList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+
+	id := d.EqualsQuals["gist_id"].GetStringValue()
+
+	gist, _, err := client.Gists.Get(ctx, id)
+	if err != nil {
+		plugin.Logger(ctx).Error("github_gist_file", "api_error", err)
+		return nil, err
+	}
+
+	// Return the files in a stable order
+	var filenames []string
+	for name := range gist.Files {
+		filenames = append(filenames, string(name))
+	}
+	sort.Strings(filenames)
+
+	for _, name := range filenames {
+		file := gist.Files[github.GistFilename(name)]
+		row := gistFile{
+			GistID:     gist.GetID(),
+			OwnerLogin: gist.GetOwner().GetLogin(),
+			GistFile:   file,
+			// The API truncates the content of files larger than a megabyte
+			Truncated: len(file.GetContent()) < file.GetSize(),
+		}
+
+		if row.Truncated && slices.Contains(d.QueryContext.Columns, "content") {
+			content, err := getGistFileRawContent(ctx, client, file.GetRawURL())
+			if err != nil {
+				plugin.Logger(ctx).Error("github_gist_file", "api_error", err)
+				return nil, err
+			}
+			row.Content = &content
+		}
+
+		d.StreamListItem(ctx, row)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func getGistFileRawContent(ctx context.Context, client *github.Client, rawURL string) (string, error) {
+	req, err := client.NewRequest("GET", rawURL, nil)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	_, err = client.Do(ctx, req, &buf)
+	if err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
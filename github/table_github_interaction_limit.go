@@ -0,0 +1,60 @@
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v55/github"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubInteractionLimit() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_interaction_limit",
+		Description: "Active interaction limits that temporarily restrict who can comment, open issues or create pull requests in a repository or organization.",
+		List: &plugin.ListConfig{
+			KeyColumns:        plugin.AnyColumn([]string{"repository_full_name", "organization"}),
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubInteractionLimitList,
+		},
+		Columns: []*plugin.Column{
+			{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromQual("repository_full_name"), Description: "Full name of the repository the limit applies to."},
+			{Name: "organization", Type: proto.ColumnType_STRING, Transform: transform.FromQual("organization"), Description: "The login of the organization the limit applies to."},
+			{Name: "limit_type", Type: proto.ColumnType_STRING, Transform: transform.FromField("Limit"), Description: "The group of users that can still interact, one of existing_users, contributors_only or collaborators_only."},
+			{Name: "origin", Type: proto.ColumnType_STRING, Description: "Where the limit is set, either repository or organization. A repository limit may be inherited from its organization."},
+			{Name: "expires_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("ExpiresAt").NullIfZero().Transform(convertTimestamp), Description: "Timestamp when the limit expires."},
+		},
+	}
+}
+
+func tableGitHubInteractionLimitList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+
+	quals := d.EqualsQuals
+	fullName := quals["repository_full_name"].GetStringValue()
+	org := quals["organization"].GetStringValue()
+
+	var restriction *github.InteractionRestriction
+	var err error
+	switch {
+	// The repository limit includes any limit inherited from the organization
+	case fullName != "":
+		owner, repo := parseRepoFullName(fullName)
+		restriction, _, err = client.Interactions.GetRestrictionsForRepo(ctx, owner, repo)
+	default:
+		restriction, _, err = client.Interactions.GetRestrictionsForOrg(ctx, org)
+	}
+	if err != nil {
+		plugin.Logger(ctx).Error("github_interaction_limit", "api_error", err)
+		return nil, err
+	}
+
+	// An empty response is returned if there is no active limit
+	if restriction != nil && restriction.Limit != nil {
+		d.StreamListItem(ctx, restriction)
+	}
+
+	return nil, nil
+}
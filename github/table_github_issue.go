@@ -3,6 +3,8 @@ package github
 import (
 	"context"
 	"fmt"
+	"maps"
+	"strings"
 	"time"
 
 	"github.com/shurcooL/githubv4"
@@ -15,7 +17,11 @@ import (
 
 func gitHubIssueColumns() []*plugin.Column {
 	tableCols := []*plugin.Column{
-		{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromQual("repository_full_name"), Description: "The full name of the repository (login/repo-name)."},
+		{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.From(issueRepositoryFullName), Description: "The full name of the repository (login/repo-name)."},
+		{Name: "organization", Type: proto.ColumnType_STRING, Hydrate: issueHydrateOrganization, Transform: transform.FromValue(), Description: "The login of the owner of the repository of the issue, used as a filter to list the issues across all of the repositories of an organization."},
+		{Name: "assignee_login", Type: proto.ColumnType_STRING, Hydrate: issueHydrateAssigneeLogin, Transform: transform.FromValue(), Description: "The login of a user assigned to the issue, only populated when used as a filter and the user is assigned to the issue."},
+		{Name: "label", Type: proto.ColumnType_STRING, Hydrate: issueHydrateLabel, Transform: transform.FromValue(), Description: "The name of a label applied to the issue, only populated when used as a filter and the label is applied to the issue."},
+		{Name: "raw_json", Type: proto.ColumnType_JSON, Hydrate: issueHydrateRawJSON, Transform: transform.FromValue(), Description: "The issue as returned by the REST API, including the fields which have no column. Fetching it requires an additional API request per issue."},
 	}
 
 	return append(tableCols, sharedIssueColumns()...)
@@ -34,6 +40,9 @@ func sharedIssueColumns() []*plugin.Column {
 		{Name: "body_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("BodyUrl", "Node.BodyUrl"), Description: "URL for this issue body."},
 		{Name: "closed", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Closed", "Node.Closed"), Description: "If true, issue is closed."},
 		{Name: "closed_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("ClosedAt", "Node.ClosedAt").NullIfZero().Transform(convertTimestamp), Description: "Timestamp when issue was closed."},
+		{Name: "closed_by", Type: proto.ColumnType_JSON, Hydrate: issueHydrateClosedBy, Transform: transform.FromValue().NullIfZero(), Description: "The actor who last closed the issue."},
+		{Name: "closed_by_login", Type: proto.ColumnType_STRING, Hydrate: issueHydrateClosedByLogin, Transform: transform.FromValue().NullIfZero(), Description: "The login of the actor who last closed the issue."},
+		{Name: "closing_pull_requests", Type: proto.ColumnType_JSON, Transform: transform.FromField("ClosedByPullRequestsReferences.Nodes", "Node.ClosedByPullRequestsReferences.Nodes").NullIfZero(), Description: "The first 10 pull requests linked to the issue, which close it when they are merged, including those already closed or merged."},
 		{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("CreatedAt", "Node.CreatedAt").NullIfZero().Transform(convertTimestamp), Description: "Timestamp when issue was created."},
 		{Name: "created_via_email", Type: proto.ColumnType_BOOL, Transform: transform.FromField("CreatedViaEmail", "Node.CreatedViaEmail"), Description: "If true, issue was created via email."},
 		{Name: "editor", Type: proto.ColumnType_JSON, Hydrate: issueHydrateEditor, Transform: transform.FromValue().NullIfZero(), Description: "The actor who edited the issue."},
@@ -46,12 +55,14 @@ func sharedIssueColumns() []*plugin.Column {
 		{Name: "milestone", Type: proto.ColumnType_JSON, Hydrate: issueHydrateMilestone, Transform: transform.FromValue().NullIfZero(), Description: "The milestone associated with the issue."},
 		{Name: "published_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("PublishedAt", "Node.PublishedAt").NullIfZero().Transform(convertTimestamp), Description: "Timestamp when issue was published."},
 		{Name: "state", Type: proto.ColumnType_STRING, Transform: transform.FromField("State", "Node.State"), Description: "The state of the issue."},
-		{Name: "state_reason", Type: proto.ColumnType_STRING, Transform: transform.FromField("StateReason", "Node.StateReason"), Description: "The reason for the issue state."},
+		{Name: "state_reason", Type: proto.ColumnType_STRING, Transform: transform.FromField("StateReason", "Node.StateReason").NullIfZero(), Description: "The reason for the issue state, one of COMPLETED, NOT_PLANNED, DUPLICATE or REOPENED."},
 		{Name: "title", Type: proto.ColumnType_STRING, Transform: transform.FromField("Title", "Node.Title"), Description: "The title of the issue."},
 		{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("UpdatedAt", "Node.UpdatedAt").NullIfZero().Transform(convertTimestamp), Description: "Timestamp when issue was last updated."},
 		{Name: "url", Type: proto.ColumnType_STRING, Transform: transform.FromField("Url", "Node.Url"), Description: "URL for the issue."},
 		{Name: "assignees_total_count", Type: proto.ColumnType_INT, Hydrate: issueHydrateAssigneeCount, Transform: transform.FromValue(), Description: "Count of assignees on the issue."},
 		{Name: "comments_total_count", Type: proto.ColumnType_INT, Hydrate: issueHydrateCommentCount, Transform: transform.FromValue(), Description: "Count of comments on the issue."},
+		{Name: "reactions_total_count", Type: proto.ColumnType_INT, Transform: transform.FromField("ReactionGroups", "Node.ReactionGroups").Transform(reactionGroupsTotalCount), Description: "Count of reactions on the issue."},
+		{Name: "reactions", Type: proto.ColumnType_JSON, Transform: transform.FromField("ReactionGroups", "Node.ReactionGroups").Transform(reactionGroupsToMap), Description: "A map of the count of reactions on the issue by content, for example THUMBS_UP or HEART."},
 		{Name: "labels_total_count", Type: proto.ColumnType_INT, Hydrate: issueHydrateLabelsCount, Transform: transform.FromValue(), Description: "Count of labels on the issue."},
 		{Name: "labels_src", Type: proto.ColumnType_JSON, Hydrate: issueHydrateLabels, Transform: transform.FromValue(), Description: "The first 100 labels associated to the issue."},
 		{Name: "labels", Type: proto.ColumnType_JSON, Description: "A map of labels for the issue.", Hydrate: issueHydrateLabels, Transform: transform.FromValue().Transform(LabelTransform)},
@@ -73,8 +84,17 @@ func tableGitHubIssue() *plugin.Table {
 		List: &plugin.ListConfig{
 			KeyColumns: []*plugin.KeyColumn{
 				{
-					Name:    "repository_full_name",
-					Require: plugin.Required,
+					Name:      "repository_full_name",
+					Require:   plugin.Optional,
+					Operators: []string{"=", "~~"},
+				},
+				{
+					Name:    "organization",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "node_id",
+					Require: plugin.Optional,
 				},
 				{
 					Name:    "author_login",
@@ -84,10 +104,23 @@ func tableGitHubIssue() *plugin.Table {
 					Name:    "state",
 					Require: plugin.Optional,
 				},
+				{
+					Name:    "assignee_login",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "label",
+					Require: plugin.Optional,
+				},
 				{
 					Name:      "updated_at",
 					Require:   plugin.Optional,
-					Operators: []string{">", ">="},
+					Operators: []string{">", ">=", "<", "<="},
+				},
+				{
+					Name:      "created_at",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "<", "<="},
 				},
 			},
 			ShouldIgnoreError: isNotFoundError([]string{"404"}),
@@ -104,11 +137,15 @@ func tableGitHubIssue() *plugin.Table {
 
 func tableGitHubRepositoryIssueList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	quals := d.EqualsQuals
-	fullName := quals["repository_full_name"].GetStringValue()
-	owner, repoName := parseRepoFullName(fullName)
 
 	pageSize := adjustPageSize(100, d.QueryContext.Limit)
 
+	// The issue is looked up directly when the node ID is known, e.g. from a
+	// webhook payload or the audit log
+	if quals["node_id"] != nil {
+		return tableGitHubIssueNodeList(ctx, d, quals["node_id"].GetStringValue())
+	}
+
 	var filters githubv4.IssueFilters
 
 	if quals["state"] != nil {
@@ -131,6 +168,28 @@ func tableGitHubRepositoryIssueList(ctx context.Context, d *plugin.QueryData, h
 		filters.CreatedBy = githubv4.NewString(githubv4.String(author))
 	}
 
+	if quals["assignee_login"] != nil {
+		assignee := quals["assignee_login"].GetStringValue()
+		filters.Assignee = githubv4.NewString(githubv4.String(assignee))
+	}
+
+	if quals["label"] != nil {
+		label := quals["label"].GetStringValue()
+		filters.Labels = &[]githubv4.String{githubv4.String(label)}
+	}
+
+	// Issues across all of the repositories of an organization can only be
+	// listed through the search API, which returns at most 1,000 results
+	if d.Quals["repository_full_name"] == nil && quals["organization"] != nil {
+		return tableGitHubIssueSearchList(ctx, d, []string{"org:" + quals["organization"].GetStringValue()})
+	}
+
+	fullNames, err := repositoryFullNamesFromQuals(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("github_issue", "invalid filter", "repository_full_name", err)
+		return nil, err
+	}
+
 	if d.Quals["updated_at"] != nil {
 		for _, q := range d.Quals["updated_at"].Quals {
 			givenTime := q.Value.GetTimestampValue().AsTime()
@@ -145,49 +204,174 @@ func tableGitHubRepositoryIssueList(ctx context.Context, d *plugin.QueryData, h
 		}
 	}
 
-	var query struct {
-		RateLimit  models.RateLimit
-		Repository struct {
-			Issues struct {
-				PageInfo   models.PageInfo
-				TotalCount int
-				Nodes      []models.Issue
-			} `graphql:"issues(first: $pageSize, after: $cursor, filterBy: $filters)"`
-		} `graphql:"repository(owner: $owner, name: $name)"`
+	// The filterBy argument has no creation time filter and only supports a
+	// lower bound on the update time, so when either is filtered on the
+	// issues are listed newest first and the listing stops once they are
+	// older than the range
+	createdAtQuals := d.Quals["created_at"]
+	updatedAtQuals := d.Quals["updated_at"]
+	orderBy := githubv4.IssueOrder{Field: githubv4.IssueOrderFieldCreatedAt, Direction: githubv4.OrderDirectionAsc}
+	switch {
+	case createdAtQuals != nil:
+		orderBy = githubv4.IssueOrder{Field: githubv4.IssueOrderFieldCreatedAt, Direction: githubv4.OrderDirectionDesc}
+	case updatedAtQuals != nil:
+		orderBy = githubv4.IssueOrder{Field: githubv4.IssueOrderFieldUpdatedAt, Direction: githubv4.OrderDirectionDesc}
 	}
 
-	variables := map[string]interface{}{
-		"owner":    githubv4.String(owner),
-		"name":     githubv4.String(repoName),
+	baseVariables := map[string]interface{}{
 		"pageSize": githubv4.Int(pageSize),
-		"cursor":   (*githubv4.String)(nil),
 		"filters":  filters,
+		"orderBy":  orderBy,
 	}
-	appendIssueColumnIncludes(&variables, d.QueryContext.Columns)
+	appendIssueColumnIncludes(&baseVariables, d.QueryContext.Columns)
 
 	client := connectV4(ctx, d)
 
-	for {
-		err := client.Query(ctx, &query, variables)
-		plugin.Logger(ctx).Debug(rateLimitLogString("github_issue", &query.RateLimit))
-		if err != nil {
-			plugin.Logger(ctx).Error("github_issue", "api_error", err)
-			return nil, err
+	listRepositoryIssues := func(ctx context.Context, fullName string) error {
+		var query struct {
+			RateLimit  models.RateLimit
+			Repository struct {
+				Issues struct {
+					PageInfo   models.PageInfo
+					TotalCount int
+					Nodes      []models.Issue
+				} `graphql:"issues(first: $pageSize, after: $cursor, filterBy: $filters, orderBy: $orderBy)"`
+			} `graphql:"repository(owner: $owner, name: $name)"`
 		}
 
-		for _, issue := range query.Repository.Issues.Nodes {
-			d.StreamListItem(ctx, issue)
+		owner, repoName := parseRepoFullName(fullName)
+		variables := maps.Clone(baseVariables)
+		variables["owner"] = githubv4.String(owner)
+		variables["name"] = githubv4.String(repoName)
+		variables["cursor"] = (*githubv4.String)(nil)
+
+		for {
+			err := client.Query(ctx, &query, variables)
+			plugin.Logger(ctx).Debug(rateLimitLogString("github_issue", &query.RateLimit))
+			if err != nil {
+				plugin.Logger(ctx).Error("github_issue", "api_error", err)
+				return err
+			}
+
+			passedRange := false
+			for _, issue := range query.Repository.Issues.Nodes {
+				// The issues are ordered by the first of the creation and
+				// update times filtered on, the other is only filtered here
+				if createdAtQuals != nil {
+					position := timeRangePosition(issue.CreatedAt.Time, createdAtQuals)
+					if position < 0 {
+						passedRange = true
+						break
+					}
+					if position > 0 {
+						continue
+					}
+				}
+				if updatedAtQuals != nil {
+					position := timeRangePosition(issue.UpdatedAt.Time, updatedAtQuals)
+					if position < 0 && createdAtQuals == nil {
+						passedRange = true
+						break
+					}
+					if position != 0 {
+						continue
+					}
+				}
+
+				d.StreamListItem(ctx, issue)
 
-			// Context can be cancelled due to manual cancellation or the limit has been hit
-			if d.RowsRemaining(ctx) == 0 {
-				return nil, nil
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil
+				}
 			}
+
+			if passedRange || !query.Repository.Issues.PageInfo.HasNextPage {
+				return nil
+			}
+			variables["cursor"] = githubv4.NewString(query.Repository.Issues.PageInfo.EndCursor)
 		}
+	}
+
+	return nil, forEachRepository(ctx, d, fullNames, listRepositoryIssues)
+}
 
-		if !query.Repository.Issues.PageInfo.HasNextPage {
-			break
+// issueSearchQualifiers translates the quals to search qualifiers, the state
+// has already been validated by the caller
+func issueSearchQualifiers(d *plugin.QueryData) string {
+	quals := d.EqualsQuals
+
+	qualifiers := "is:issue"
+	if quals["state"] != nil {
+		qualifiers += " is:" + strings.ToLower(quals["state"].GetStringValue())
+	}
+	if quals["author_login"] != nil {
+		qualifiers += " author:" + quals["author_login"].GetStringValue()
+	}
+	if quals["assignee_login"] != nil {
+		qualifiers += " assignee:" + quals["assignee_login"].GetStringValue()
+	}
+	if quals["label"] != nil {
+		qualifiers += fmt.Sprintf(" label:%q", quals["label"].GetStringValue())
+	}
+	for _, column := range []string{"created_at", "updated_at"} {
+		if d.Quals[column] == nil {
+			continue
+		}
+		qualifier := strings.TrimSuffix(column, "_at")
+		for _, q := range d.Quals[column].Quals {
+			givenTime := q.Value.GetTimestampValue().AsTime()
+			qualifiers += fmt.Sprintf(" %s:%s%s", qualifier, q.Operator, givenTime.Format(time.RFC3339))
+		}
+	}
+
+	return qualifiers
+}
+
+func tableGitHubIssueSearchList(ctx context.Context, d *plugin.QueryData, scopes []string) (interface{}, error) {
+	var query struct {
+		RateLimit models.RateLimit
+		Search    struct {
+			PageInfo models.PageInfo
+			Edges    []models.SearchIssueResult
+		} `graphql:"search(type: ISSUE, first: $pageSize, after: $cursor, query: $query)"`
+	}
+
+	pageSize := adjustPageSize(100, d.QueryContext.Limit)
+	variables := map[string]interface{}{
+		"pageSize": githubv4.Int(pageSize),
+	}
+	appendIssueColumnIncludes(&variables, d.QueryContext.Columns)
+
+	qualifiers := issueSearchQualifiers(d)
+	client := connectV4(ctx, d)
+
+	for _, scope := range scopes {
+		variables["query"] = githubv4.String(scope + " " + qualifiers)
+		variables["cursor"] = (*githubv4.String)(nil)
+
+		for {
+			err := client.Query(ctx, &query, variables)
+			plugin.Logger(ctx).Debug(rateLimitLogString("github_issue", &query.RateLimit))
+			if err != nil {
+				plugin.Logger(ctx).Error("github_issue", "api_error", err)
+				return nil, err
+			}
+
+			for _, issue := range query.Search.Edges {
+				d.StreamListItem(ctx, issue)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+
+			if !query.Search.PageInfo.HasNextPage {
+				break
+			}
+			variables["cursor"] = githubv4.NewString(query.Search.PageInfo.EndCursor)
 		}
-		variables["cursor"] = githubv4.NewString(query.Repository.Issues.PageInfo.EndCursor)
 	}
 
 	return nil, nil
@@ -225,6 +409,167 @@ func tableGitHubRepositoryIssueGet(ctx context.Context, d *plugin.QueryData, h *
 	return query.Repository.Issue, nil
 }
 
+func tableGitHubIssueNodeList(ctx context.Context, d *plugin.QueryData, nodeId string) (interface{}, error) {
+	client := connectV4(ctx, d)
+
+	var query struct {
+		RateLimit models.RateLimit
+		Node      struct {
+			Issue models.Issue `graphql:"... on Issue"`
+		} `graphql:"node(id: $nodeId)"`
+	}
+
+	variables := map[string]interface{}{
+		"nodeId": githubv4.ID(nodeId),
+	}
+	appendIssueColumnIncludes(&variables, d.QueryContext.Columns)
+	if d.EqualsQuals["assignee_login"] != nil {
+		variables["includeIssueAssignees"] = githubv4.Boolean(true)
+	}
+	if d.EqualsQuals["label"] != nil {
+		variables["includeIssueLabels"] = githubv4.Boolean(true)
+	}
+
+	err := client.Query(ctx, &query, variables)
+	plugin.Logger(ctx).Debug(rateLimitLogString("github_issue", &query.RateLimit))
+	if err != nil {
+		plugin.Logger(ctx).Error("github_issue", "api_error", err)
+		if strings.Contains(err.Error(), "Could not resolve to a node with the global id of") {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// The node is not an issue, or not one matching the other quals, which
+	// are only pushed down when the issues are listed
+	if query.Node.Issue.NodeId == "" || !matchesRepositoryFullNameQuals(d, query.Node.Issue.Repo.NameWithOwner) || !matchesIssueQuals(d, query.Node.Issue) {
+		return nil, nil
+	}
+
+	d.StreamListItem(ctx, query.Node.Issue)
+	return nil, nil
+}
+
+// issueRepositoryFullName returns the repository of the issue, spelled as in the repository_full_name qual if the qual
+// names the same repository in another case
+func issueRepositoryFullName(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	var fullName string
+	switch issue := d.HydrateItem.(type) {
+	case models.Issue:
+		fullName = issue.Repo.NameWithOwner
+	case models.SearchIssueResult:
+		fullName = issue.Node.Repo.NameWithOwner
+	}
+
+	if q, ok := d.KeyColumnQuals["repository_full_name"]; ok && len(q) > 0 && q[0].Operator == "=" {
+		if name := q[0].Value.GetStringValue(); name != "" && (fullName == "" || strings.EqualFold(name, fullName)) {
+			return name, nil
+		}
+	}
+
+	if fullName == "" {
+		return nil, nil
+	}
+	return fullName, nil
+}
+
+// matchesIssueQuals returns whether the issue matches the state, organization, assignee_login and label quals, for
+// issues which are looked up by node ID rather than listed with the quals as filters
+func matchesIssueQuals(d *plugin.QueryData, issue models.Issue) bool {
+	quals := d.EqualsQuals
+	if quals["state"] != nil && string(issue.State) != quals["state"].GetStringValue() {
+		return false
+	}
+	if quals["organization"] != nil {
+		owner, _ := parseRepoFullName(issue.Repo.NameWithOwner)
+		if !strings.EqualFold(owner, quals["organization"].GetStringValue()) {
+			return false
+		}
+	}
+	if quals["assignee_login"] != nil && issueAssigneeLogin(issue, quals["assignee_login"].GetStringValue()) == nil {
+		return false
+	}
+	if quals["label"] != nil && issueLabel(issue, quals["label"].GetStringValue()) == nil {
+		return false
+	}
+	return true
+}
+
+// issueQualValue returns the value of the equals qual on the column. A Get call only has the quals of its key
+// columns, so the quals of the other columns are read from the query context.
+func issueQualValue(d *plugin.QueryData, column string) string {
+	if q := d.EqualsQuals[column]; q != nil {
+		return q.GetStringValue()
+	}
+	if q := d.QueryContext.UnsafeQuals[column]; q != nil {
+		for _, qual := range q.Quals {
+			if qual.GetStringValue() == "=" {
+				return qual.GetValue().GetStringValue()
+			}
+		}
+	}
+	return ""
+}
+
+// issueAssigneeLogin returns the login as spelled in the qual if the user is assigned to the issue
+func issueAssigneeLogin(issue models.Issue, login string) interface{} {
+	for _, assignee := range issue.AssigneeLogins.Nodes {
+		if strings.EqualFold(assignee.Login, login) {
+			return login
+		}
+	}
+	return nil
+}
+
+// issueLabel returns the label name as spelled in the qual if the label is applied to the issue
+func issueLabel(issue models.Issue, name string) interface{} {
+	for _, label := range issue.Labels.Nodes {
+		if strings.EqualFold(label.Name, name) {
+			return name
+		}
+	}
+	return nil
+}
+
+func issueHydrateOrganization(_ context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	issue, err := extractIssueFromHydrateItem(h)
+	if err != nil {
+		return nil, err
+	}
+	owner, _ := parseRepoFullName(issue.Repo.NameWithOwner)
+	if owner == "" {
+		return nil, nil
+	}
+	if org := issueQualValue(d, "organization"); strings.EqualFold(org, owner) {
+		return org, nil
+	}
+	return owner, nil
+}
+
+func issueHydrateAssigneeLogin(_ context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	issue, err := extractIssueFromHydrateItem(h)
+	if err != nil {
+		return nil, err
+	}
+	login := issueQualValue(d, "assignee_login")
+	if login == "" {
+		return nil, nil
+	}
+	return issueAssigneeLogin(issue, login), nil
+}
+
+func issueHydrateLabel(_ context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	issue, err := extractIssueFromHydrateItem(h)
+	if err != nil {
+		return nil, err
+	}
+	name := issueQualValue(d, "label")
+	if name == "" {
+		return nil, nil
+	}
+	return issueLabel(issue, name), nil
+}
+
 func LabelTransform(ctx context.Context, input *transform.TransformData) (interface{}, error) {
 	labels := make(map[string]bool)
 	t := fmt.Sprintf("%T", input.Value)
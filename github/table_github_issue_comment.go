@@ -2,6 +2,12 @@ package github
 
 import (
 	"context"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v55/github"
 	"github.com/shurcooL/githubv4"
 	"github.com/turbot/steampipe-plugin-github/github/models"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
@@ -11,8 +17,8 @@ import (
 
 func sharedCommentsColumns() []*plugin.Column {
 	return []*plugin.Column{
-		{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromQual("repository_full_name"), Description: "The full name of the repository (login/repo-name)."},
-		{Name: "number", Type: proto.ColumnType_INT, Transform: transform.FromQual("number"), Description: "The issue/pr number."},
+		{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.From(commentRepositoryFullName), Description: "The full name of the repository (login/repo-name)."},
+		{Name: "number", Type: proto.ColumnType_INT, Transform: transform.From(commentNumber), Description: "The issue/pr number."},
 		{Name: "id", Type: proto.ColumnType_INT, Transform: transform.FromField("Id", "Node.Id"), Description: "The ID of the comment."},
 		{Name: "node_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("NodeId", "Node.NodeId"), Description: "The node ID of the comment."},
 		{Name: "author", Type: proto.ColumnType_JSON, Transform: transform.FromField("Author", "Node.Author").NullIfZero(), Description: "The actor who authored the comment."},
@@ -37,6 +43,9 @@ func sharedCommentsColumns() []*plugin.Column {
 		{Name: "can_update", Type: proto.ColumnType_BOOL, Transform: transform.FromField("CanUpdate", "Node.CanUpdate"), Description: "If true, user can update the comment."},
 		{Name: "cannot_update_reasons", Type: proto.ColumnType_JSON, Transform: transform.FromField("CannotUpdateReasons", "Node.CannotUpdateReasons").NullIfZero(), Description: "A list of reasons why user cannot update the comment."},
 		{Name: "did_author", Type: proto.ColumnType_BOOL, Transform: transform.FromField("DidAuthor", "Node.DidAuthor"), Description: "If true, user authored the comment."},
+		{Name: "reactions_total_count", Type: proto.ColumnType_INT, Transform: transform.FromField("ReactionGroups", "Node.ReactionGroups").Transform(reactionGroupsTotalCount), Description: "Count of reactions on the comment."},
+		{Name: "reactions", Type: proto.ColumnType_JSON, Transform: transform.FromField("ReactionGroups", "Node.ReactionGroups").Transform(reactionGroupsToMap), Description: "A map of the count of reactions on the comment by content, for example THUMBS_UP or HEART."},
+		{Name: "reactors", Type: proto.ColumnType_JSON, Hydrate: commentHydrateReactors, Transform: transform.FromValue().NullIfZero(), Description: "The reactions on the comment with the login of the user that reacted."},
 	}
 }
 
@@ -45,66 +54,273 @@ func tableGitHubIssueComment() *plugin.Table {
 		Name:        "github_issue_comment",
 		Description: "GitHub Issue Comments are the responses/comments on GitHub Issues.",
 		List: &plugin.ListConfig{
-			KeyColumns:        plugin.AllColumns([]string{"repository_full_name", "number"}),
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "repository_full_name", Require: plugin.Required, Operators: []string{"=", "~~"}},
+				{Name: "number", Require: plugin.Optional},
+				{Name: "updated_at", Require: plugin.Optional, Operators: []string{">", ">="}},
+			},
 			ShouldIgnoreError: isNotFoundError([]string{"404"}),
 			Hydrate:           tableGitHubRepositoryIssueCommentList,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("node_id"),
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubIssueCommentGet,
+		},
 		Columns: sharedCommentsColumns(),
 	}
 }
 
 func tableGitHubRepositoryIssueCommentList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	quals := d.EqualsQuals
-	issueNumber := int(quals["number"].GetInt64Value())
-	fullName := quals["repository_full_name"].GetStringValue()
-	owner, repoName := parseRepoFullName(fullName)
 
+	fullNames, err := repositoryFullNamesFromQuals(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("github_issue_comment", "invalid filter", "repository_full_name", err)
+		return nil, err
+	}
+
+	// The comments of all of the issues of a repository can only be listed
+	// through the REST API
+	if quals["number"] == nil {
+		return nil, forEachRepository(ctx, d, fullNames, func(ctx context.Context, fullName string) error {
+			return listRepositoryIssueCommentsAll(ctx, d, fullName)
+		})
+	}
+
+	issueNumber := int(quals["number"].GetInt64Value())
 	pageSize := adjustPageSize(100, d.QueryContext.Limit)
+	client := connectV4(ctx, d)
+
+	listRepositoryIssueComments := func(ctx context.Context, fullName string) error {
+		var query struct {
+			RateLimit  models.RateLimit
+			Repository struct {
+				Issue struct {
+					Comments struct {
+						PageInfo   models.PageInfo
+						TotalCount int
+						Nodes      []models.IssueComment
+					} `graphql:"comments(first: $pageSize, after: $cursor)"`
+				} `graphql:"issue(number: $issueNumber)"`
+			} `graphql:"repository(owner: $owner, name: $name)"`
+		}
+
+		owner, repoName := parseRepoFullName(fullName)
+		variables := map[string]interface{}{
+			"owner":       githubv4.String(owner),
+			"name":        githubv4.String(repoName),
+			"issueNumber": githubv4.Int(issueNumber),
+			"pageSize":    githubv4.Int(pageSize),
+			"cursor":      (*githubv4.String)(nil),
+		}
+
+		for {
+			err := client.Query(ctx, &query, variables)
+			plugin.Logger(ctx).Debug(rateLimitLogString("github_issue_comment", &query.RateLimit))
+			if err != nil {
+				plugin.Logger(ctx).Error("github_issue_comment", "api_error", err)
+				return err
+			}
+
+			for _, comment := range query.Repository.Issue.Comments.Nodes {
+				d.StreamListItem(ctx, comment)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil
+				}
+			}
+
+			if !query.Repository.Issue.Comments.PageInfo.HasNextPage {
+				return nil
+			}
+			variables["cursor"] = githubv4.NewString(query.Repository.Issue.Comments.PageInfo.EndCursor)
+		}
+	}
+
+	return nil, forEachRepository(ctx, d, fullNames, listRepositoryIssueComments)
+}
+
+func tableGitHubIssueCommentGet(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	comment, _, err := getIssueCommentByNodeId(ctx, d, "github_issue_comment", d.EqualsQuals["node_id"].GetStringValue())
+	if err != nil || comment == nil {
+		return nil, err
+	}
+	return *comment, nil
+}
 
+// getIssueCommentByNodeId returns the comment with the given node ID, along with whether it was made on a pull request.
+// Nil is returned if there is no such node or the node is not an issue comment.
+func getIssueCommentByNodeId(ctx context.Context, d *plugin.QueryData, table string, nodeId string) (*models.IssueComment, bool, error) {
 	var query struct {
-		RateLimit  models.RateLimit
-		Repository struct {
-			Issue struct {
-				Comments struct {
-					PageInfo   models.PageInfo
-					TotalCount int
-					Nodes      []models.IssueComment
-				} `graphql:"comments(first: $pageSize, after: $cursor)"`
-			} `graphql:"issue(number: $issueNumber)"`
-		} `graphql:"repository(owner: $owner, name: $name)"`
+		RateLimit models.RateLimit
+		Node      struct {
+			IssueComment struct {
+				models.IssueComment
+				PullRequest *struct {
+					Number int
+				}
+			} `graphql:"... on IssueComment"`
+		} `graphql:"node(id: $nodeId)"`
 	}
 
 	variables := map[string]interface{}{
-		"owner":       githubv4.String(owner),
-		"name":        githubv4.String(repoName),
-		"issueNumber": githubv4.Int(issueNumber),
-		"pageSize":    githubv4.Int(pageSize),
-		"cursor":      (*githubv4.String)(nil),
+		"nodeId": githubv4.ID(nodeId),
 	}
 
 	client := connectV4(ctx, d)
+	err := client.Query(ctx, &query, variables)
+	plugin.Logger(ctx).Debug(rateLimitLogString(table, &query.RateLimit))
+	if err != nil {
+		plugin.Logger(ctx).Error(table, "api_error", err)
+		if strings.Contains(err.Error(), "Could not resolve to a node with the global id of") {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	if query.Node.IssueComment.NodeId == "" {
+		return nil, false, nil
+	}
+	return &query.Node.IssueComment.IssueComment, query.Node.IssueComment.PullRequest != nil, nil
+}
+
+// listRepositoryIssueCommentsAll lists the comments of all of the issues of the repository
+func listRepositoryIssueCommentsAll(ctx context.Context, d *plugin.QueryData, fullName string) error {
+	owner, repoName := parseRepoFullName(fullName)
+
+	opts := &github.IssueListCommentsOptions{
+		Sort:        github.String("updated"),
+		Direction:   github.String("asc"),
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit < int64(opts.ListOptions.PerPage) {
+			opts.ListOptions.PerPage = int(*limit)
+		}
+	}
+
+	if d.Quals["updated_at"] != nil {
+		for _, q := range d.Quals["updated_at"].Quals {
+			givenTime := q.Value.GetTimestampValue().AsTime()
+			if q.Operator == ">" {
+				givenTime = givenTime.Add(time.Second * 1)
+			}
+			opts.Since = &givenTime
+		}
+	}
+
+	client := connect(ctx, d)
+
 	for {
-		err := client.Query(ctx, &query, variables)
-		plugin.Logger(ctx).Debug(rateLimitLogString("github_issue_comment", &query.RateLimit))
+		comments, resp, err := client.Issues.ListComments(ctx, owner, repoName, 0, opts)
 		if err != nil {
 			plugin.Logger(ctx).Error("github_issue_comment", "api_error", err)
-			return nil, err
+			return err
 		}
 
-		for _, comment := range query.Repository.Issue.Comments.Nodes {
-			d.StreamListItem(ctx, comment)
+		for _, comment := range comments {
+			d.StreamListItem(ctx, newIssueCommentFromRest(fullName, comment))
 
 			// Context can be cancelled due to manual cancellation or the limit has been hit
 			if d.RowsRemaining(ctx) == 0 {
-				return nil, nil
+				return nil
 			}
 		}
 
-		if !query.Repository.Issue.Comments.PageInfo.HasNextPage {
+		if resp.NextPage == 0 {
 			break
 		}
-		variables["cursor"] = githubv4.NewString(query.Repository.Issue.Comments.PageInfo.EndCursor)
+		opts.ListOptions.Page = resp.NextPage
+	}
+
+	return nil
+}
+
+// newIssueCommentFromRest maps the fields returned by the REST API to an issue comment, the remaining fields are only
+// available through the GraphQL API
+func newIssueCommentFromRest(fullName string, comment *github.IssueComment) models.IssueComment {
+	result := models.IssueComment{
+		Id:                int(comment.GetID()),
+		NodeId:            comment.GetNodeID(),
+		AuthorAssociation: githubv4.CommentAuthorAssociation(comment.GetAuthorAssociation()),
+		Body:              comment.GetBody(),
+		Url:               comment.GetHTMLURL(),
+	}
+	result.Repository.NameWithOwner = fullName
+	if comment.Reactions != nil {
+		result.ReactionGroups = reactionGroupsFromRest(comment.Reactions)
+	}
+	if comment.User != nil {
+		result.Author = models.Actor{
+			AvatarUrl: comment.User.GetAvatarURL(),
+			Login:     comment.User.GetLogin(),
+			Url:       comment.User.GetHTMLURL(),
+		}
+	}
+	if comment.CreatedAt != nil {
+		result.CreatedAt = models.NullableTime{Time: comment.CreatedAt.Time}
+	}
+	if comment.UpdatedAt != nil {
+		result.UpdatedAt = models.NullableTime{Time: comment.UpdatedAt.Time}
+	}
+	return result
+}
+
+func commentHydrateReactors(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	comment, ok := h.Item.(models.IssueComment)
+	if !ok || comment.NodeId == "" {
+		return nil, nil
+	}
+
+	reactions, err := listReactions(ctx, d, comment.NodeId)
+	if err != nil {
+		plugin.Logger(ctx).Error("commentHydrateReactors", "api_error", err)
+		return nil, err
+	}
+	return reactions, nil
+}
+
+// commentRepositoryFullName returns the repository of the comment, spelled as in the repository_full_name qual if the
+// qual names the same repository in another case
+func commentRepositoryFullName(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	var fullName string
+	if comment, ok := d.HydrateItem.(models.IssueComment); ok {
+		fullName = comment.Repository.NameWithOwner
+	}
+
+	if q, ok := d.KeyColumnQuals["repository_full_name"]; ok && len(q) > 0 && q[0].Operator == "=" {
+		if name := q[0].Value.GetStringValue(); name != "" && (fullName == "" || strings.EqualFold(name, fullName)) {
+			return name, nil
+		}
 	}
 
-	return nil, nil
+	if fullName == "" {
+		return nil, nil
+	}
+	return fullName, nil
+}
+
+var commentUrlNumberRegexp = regexp.MustCompile(`/(?:issues|pull)/(\d+)#`)
+
+// commentNumber returns the number qual as given, or the number of the issue or pull request parsed from the URL of the
+// comment if the comments were listed for the whole repository
+func commentNumber(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if q, ok := d.KeyColumnQuals["number"]; ok && len(q) > 0 {
+		return q[0].Value.GetInt64Value(), nil
+	}
+
+	comment, ok := d.HydrateItem.(models.IssueComment)
+	if !ok {
+		return nil, nil
+	}
+
+	matches := commentUrlNumberRegexp.FindStringSubmatch(comment.Url)
+	if len(matches) < 2 {
+		return nil, nil
+	}
+	return strconv.Atoi(matches[1])
 }
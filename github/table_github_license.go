@@ -26,12 +26,11 @@ func tableGitHubLicense() *plugin.Table {
 			{Name: "spdx_id", Description: "The Software Package Data Exchange (SPDX) id of the license.", Type: proto.ColumnType_STRING, Transform: transform.FromField("SpdxId")},
 			{Name: "name", Description: "The name of the license.", Type: proto.ColumnType_STRING},
 			{Name: "url", Description: "The HTML URL of the license.", Type: proto.ColumnType_STRING, Transform: transform.FromField("Url")},
-
-			// The body is huge and of limited value, exclude it for now
-			// {Name: "body", Type: proto.ColumnType_STRING, Hydrate: tableGitHubLicenseGetData},
+			{Name: "body", Description: "The full text of the license.", Type: proto.ColumnType_STRING},
 			{Name: "conditions", Description: "An array of license conditions (include-copyright,disclose-source, etc).", Type: proto.ColumnType_JSON},
 			{Name: "description", Description: "The license description.", Type: proto.ColumnType_STRING},
 			{Name: "featured", Description: "If true, the license is 'featured' in the GitHub UI.", Type: proto.ColumnType_BOOL},
+			{Name: "hidden", Description: "If true, the license is hidden from the license picker in the GitHub UI.", Type: proto.ColumnType_BOOL},
 			{Name: "implementation", Description: "Implementation instructions for the license.", Type: proto.ColumnType_STRING},
 			{Name: "key", Description: "The unique key of the license.", Type: proto.ColumnType_STRING},
 			{Name: "limitations", Description: "An array of limitations for the license (trademark-use, liability,warranty, etc).", Type: proto.ColumnType_JSON},
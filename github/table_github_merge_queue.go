@@ -0,0 +1,105 @@
+package github
+
+import (
+	"context"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/turbot/steampipe-plugin-github/github/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubMergeQueue() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_merge_queue",
+		Description: "Pull requests waiting in the merge queue of a branch in the given repository.",
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "repository_full_name", Require: plugin.Required},
+				{Name: "branch", Require: plugin.Optional},
+			},
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubMergeQueueList,
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromQual("repository_full_name"), Description: "Full name of the repository that contains the merge queue."},
+			{Name: "branch", Type: proto.ColumnType_STRING, Transform: transform.FromField("PullRequest.BaseRefName"), Description: "The branch the merge queue merges into. Defaults to the default branch of the repository."},
+			{Name: "position", Type: proto.ColumnType_INT, Description: "The position of the entry in the queue, starting at 0."},
+			{Name: "pull_request_number", Type: proto.ColumnType_INT, Transform: transform.FromField("PullRequest.Number"), Description: "The number of the queued pull request."},
+			{Name: "pull_request_title", Type: proto.ColumnType_STRING, Transform: transform.FromField("PullRequest.Title"), Description: "The title of the queued pull request."},
+			{Name: "state", Type: proto.ColumnType_STRING, Description: "The state of the entry, one of AWAITING_CHECKS, LOCKED, MERGEABLE, QUEUED or UNMERGEABLE."},
+			{Name: "estimated_time_to_merge", Type: proto.ColumnType_INT, Transform: transform.FromField("EstimatedTimeToMerge").NullIfZero(), Description: "The estimated time in seconds until the entry is merged."},
+			{Name: "enqueued_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("EnqueuedAt").NullIfZero().Transform(convertTimestamp), Description: "Timestamp when the entry was added to the queue."},
+			// Other columns
+			{Name: "jump", Type: proto.ColumnType_BOOL, Description: "If true, the entry jumped to the front of the queue."},
+			{Name: "solo", Type: proto.ColumnType_BOOL, Description: "If true, the entry is merged on its own rather than grouped with other entries."},
+			{Name: "enqueuer_login", Type: proto.ColumnType_STRING, Transform: transform.FromField("Enqueuer.Login").NullIfZero(), Description: "The login of the actor that added the entry to the queue."},
+			{Name: "base_commit_sha", Type: proto.ColumnType_STRING, Transform: transform.FromField("BaseCommit.Oid").NullIfZero(), Description: "The SHA of the commit the entry is tested against."},
+			{Name: "head_commit_sha", Type: proto.ColumnType_STRING, Transform: transform.FromField("HeadCommit.Oid").NullIfZero(), Description: "The SHA of the commit that is tested and merged for the entry."},
+			{Name: "pull_request_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("PullRequest.Url"), Description: "The URL of the queued pull request."},
+			{Name: "node_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("NodeId"), Description: "The node ID of the merge queue entry."},
+		},
+	}
+}
+
+func tableGitHubMergeQueueList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	quals := d.EqualsQuals
+	fullName := quals["repository_full_name"].GetStringValue()
+	owner, repo := parseRepoFullName(fullName)
+
+	var query struct {
+		RateLimit  models.RateLimit
+		Repository struct {
+			MergeQueue struct {
+				Entries struct {
+					PageInfo models.PageInfo
+					Nodes    []models.MergeQueueEntry
+				} `graphql:"entries(first: $pageSize, after: $cursor)"`
+			} `graphql:"mergeQueue(branch: $branch)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+
+	// The merge queue of the default branch is returned if no branch is given
+	var branch *githubv4.String
+	if quals["branch"] != nil {
+		branch = githubv4.NewString(githubv4.String(quals["branch"].GetStringValue()))
+	}
+
+	pageSize := adjustPageSize(100, d.QueryContext.Limit)
+	variables := map[string]interface{}{
+		"owner":    githubv4.String(owner),
+		"repo":     githubv4.String(repo),
+		"branch":   branch,
+		"pageSize": githubv4.Int(pageSize),
+		"cursor":   (*githubv4.String)(nil),
+	}
+
+	client := connectV4(ctx, d)
+	for {
+		err := client.Query(ctx, &query, variables)
+		plugin.Logger(ctx).Debug(rateLimitLogString("github_merge_queue", &query.RateLimit))
+		if err != nil {
+			plugin.Logger(ctx).Error("github_merge_queue", "api_error", err)
+			return nil, err
+		}
+
+		for _, entry := range query.Repository.MergeQueue.Entries.Nodes {
+			d.StreamListItem(ctx, entry)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if !query.Repository.MergeQueue.Entries.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Repository.MergeQueue.Entries.PageInfo.EndCursor)
+	}
+
+	return nil, nil
+}
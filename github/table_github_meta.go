@@ -0,0 +1,106 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"sort"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type metaIpRange struct {
+	Service   string
+	Cidr      string
+	IpVersion int
+}
+
+func tableGitHubMeta() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_meta",
+		Description: "IP address ranges used by GitHub services, such as webhooks, Git, the API and GitHub Actions.",
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "service", Require: plugin.Optional},
+			},
+			Hydrate: tableGitHubMetaList,
+		},
+		Columns: []*plugin.Column{
+			{Name: "service", Type: proto.ColumnType_STRING, Description: "The GitHub service using the IP address range, for example hooks, web, api, git, actions or packages."},
+			{Name: "cidr", Type: proto.ColumnType_CIDR, Description: "The IP address range in CIDR notation."},
+			{Name: "ip_version", Type: proto.ColumnType_INT, Transform: transform.FromField("IpVersion"), Description: "The IP version of the range, either 4 or 6."},
+		},
+	}
+}
+
+func tableGitHubMetaList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	meta, err := getGitHubMeta(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("github_meta", "api_error", err)
+		return nil, err
+	}
+
+	service := d.EqualsQuals["service"].GetStringValue()
+
+	// The services are decoded generically so that services added by GitHub
+	// are returned without changes to the plugin
+	var services []string
+	for s := range meta {
+		services = append(services, s)
+	}
+	sort.Strings(services)
+
+	for _, s := range services {
+		if service != "" && s != service {
+			continue
+		}
+
+		// Only the services listing IP address ranges are of interest, other
+		// entries such as ssh_keys and domains are skipped
+		var values []string
+		if err := json.Unmarshal(meta[s], &values); err != nil {
+			continue
+		}
+
+		for _, value := range values {
+			ip, _, err := net.ParseCIDR(value)
+			if err != nil {
+				continue
+			}
+
+			ipVersion := 6
+			if ip.To4() != nil {
+				ipVersion = 4
+			}
+			d.StreamListItem(ctx, metaIpRange{Service: s, Cidr: value, IpVersion: ipVersion})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// getGitHubMeta returns the raw entries of the meta API, which is decoded with a raw request since the go-github
+// client only supports a fixed set of services
+func getGitHubMeta(ctx context.Context, d *plugin.QueryData) (map[string]json.RawMessage, error) {
+	client := connect(ctx, d)
+
+	req, err := client.NewRequest("GET", "meta", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var meta map[string]json.RawMessage
+	_, err = client.Do(ctx, req, &meta)
+	if err != nil {
+		return nil, err
+	}
+
+	return meta, nil
+}
@@ -0,0 +1,76 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type metaSSHKey struct {
+	Algorithm string
+	Key       string
+}
+
+func tableGitHubMetaSSHKey() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_meta_ssh_key",
+		Description: "Public SSH host keys of GitHub, used to verify connections to Git over SSH.",
+		List: &plugin.ListConfig{
+			Hydrate: tableGitHubMetaSSHKeyList,
+		},
+		Columns: []*plugin.Column{
+			{Name: "algorithm", Type: proto.ColumnType_STRING, Description: "The algorithm of the host key, for example ED25519, ECDSA or RSA."},
+			{Name: "key_type", Type: proto.ColumnType_STRING, Transform: transform.FromField("Key").Transform(sshKeyType), Description: "The type of the host key, for example ssh-ed25519."},
+			{Name: "fingerprint", Type: proto.ColumnType_STRING, Transform: transform.FromField("Key").Transform(sshKeyFingerprint), Description: "The SHA256 fingerprint of the host key, as shown when first connecting to GitHub over SSH."},
+			{Name: "key", Type: proto.ColumnType_STRING, Description: "The public host key, in the format used by known_hosts files."},
+		},
+	}
+}
+
+func tableGitHubMetaSSHKeyList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	meta, err := getGitHubMeta(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("github_meta_ssh_key", "api_error", err)
+		return nil, err
+	}
+
+	var keys []string
+	if raw, ok := meta["ssh_keys"]; ok {
+		if err := json.Unmarshal(raw, &keys); err != nil {
+			plugin.Logger(ctx).Error("github_meta_ssh_key", "decode_error", err)
+			return nil, err
+		}
+	}
+
+	for _, key := range keys {
+		d.StreamListItem(ctx, metaSSHKey{Algorithm: sshKeyAlgorithm(key), Key: key})
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// sshKeyAlgorithm returns the algorithm of the key as named in the ssh_key_fingerprints of the meta API
+func sshKeyAlgorithm(key string) string {
+	fields := strings.Fields(key)
+	if len(fields) == 0 {
+		return ""
+	}
+	switch fields[0] {
+	case "ssh-rsa":
+		return "RSA"
+	case "ssh-ed25519":
+		return "ED25519"
+	case "ecdsa-sha2-nistp256":
+		return "ECDSA"
+	}
+	return strings.ToUpper(fields[0])
+}
@@ -3,6 +3,9 @@ package github
 import (
 	"context"
 	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v55/github"
 	"github.com/shurcooL/githubv4"
 	"github.com/turbot/steampipe-plugin-github/github/models"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
@@ -15,6 +18,7 @@ import (
 func gitHubMyIssueColumns() []*plugin.Column {
 	tableCols := []*plugin.Column{
 		{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Repo.NameWithOwner", "Node.Repo.NameWithOwner"), Description: "The full name of the repository (login/repo-name)."},
+		{Name: "filter", Type: proto.ColumnType_STRING, Transform: transform.FromQual("filter"), Description: "The relationship of the issues to you, one of assigned, created, mentioned, subscribed, repos or all. Without it the issues you created are listed."},
 	}
 
 	return append(tableCols, sharedIssueColumns()...)
@@ -29,6 +33,7 @@ func tableGitHubMyIssue() *plugin.Table {
 			KeyColumns: []*plugin.KeyColumn{
 				{Name: "state", Require: plugin.Optional},
 				{Name: "updated_at", Require: plugin.Optional, Operators: []string{">", ">="}},
+				{Name: "filter", Require: plugin.Optional},
 			},
 		},
 		Columns: gitHubMyIssueColumns(),
@@ -68,6 +73,12 @@ func tableGitHubMyIssueList(ctx context.Context, d *plugin.QueryData, h *plugin.
 		}
 	}
 
+	// The issues of the viewer are only those they created, the other
+	// relationships are only available through the REST API
+	if quals["filter"] != nil {
+		return tableGitHubMyIssueFilterList(ctx, d, filters)
+	}
+
 	var query struct {
 		RateLimit models.RateLimit
 		Viewer    struct {
@@ -114,3 +125,84 @@ func tableGitHubMyIssueList(ctx context.Context, d *plugin.QueryData, h *plugin.
 
 	return nil, nil
 }
+
+// tableGitHubMyIssueFilterList lists the issues with the given relationship to
+// the authenticated user through the REST API, then fetches the listed issues
+// through the GraphQL API by node ID to populate the columns
+func tableGitHubMyIssueFilterList(ctx context.Context, d *plugin.QueryData, filters githubv4.IssueFilters) (interface{}, error) {
+	filter := d.EqualsQuals["filter"].GetStringValue()
+	switch filter {
+	case "assigned", "created", "mentioned", "subscribed", "repos", "all":
+	default:
+		plugin.Logger(ctx).Error("github_my_issue", "invalid filter", "filter", filter)
+		return nil, fmt.Errorf("invalid value for 'filter' can only filter for 'assigned', 'created', 'mentioned', 'subscribed', 'repos' or 'all' - you attempted to filter for '%s'", filter)
+	}
+
+	opts := &github.IssueListOptions{
+		Filter:      filter,
+		State:       "all",
+		ListOptions: github.ListOptions{PerPage: adjustPageSize(100, d.QueryContext.Limit)},
+	}
+	if len(*filters.States) == 1 {
+		opts.State = strings.ToLower(string((*filters.States)[0]))
+	}
+	if filters.Since != nil {
+		opts.Since = filters.Since.Time
+	}
+
+	var query struct {
+		RateLimit models.RateLimit
+		Nodes     []struct {
+			Issue models.Issue `graphql:"... on Issue"`
+		} `graphql:"nodes(ids: $ids)"`
+	}
+
+	variables := map[string]interface{}{}
+	appendIssueColumnIncludes(&variables, d.QueryContext.Columns)
+
+	client := connect(ctx, d)
+	clientV4 := connectV4(ctx, d)
+
+	for {
+		issues, resp, err := client.Issues.List(ctx, true, opts)
+		if err != nil {
+			plugin.Logger(ctx).Error("github_my_issue", "api_error", err)
+			return nil, err
+		}
+
+		// The REST API lists pull requests as issues too
+		var ids []githubv4.ID
+		for _, issue := range issues {
+			if issue.IsPullRequest() {
+				continue
+			}
+			ids = append(ids, githubv4.ID(issue.GetNodeID()))
+		}
+
+		if len(ids) > 0 {
+			variables["ids"] = ids
+			err = clientV4.Query(ctx, &query, variables)
+			plugin.Logger(ctx).Debug(rateLimitLogString("github_my_issue", &query.RateLimit))
+			if err != nil {
+				plugin.Logger(ctx).Error("github_my_issue", "api_error", err)
+				return nil, err
+			}
+
+			for _, node := range query.Nodes {
+				d.StreamListItem(ctx, node.Issue)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return nil, nil
+}
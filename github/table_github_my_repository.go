@@ -2,11 +2,23 @@ package github
 
 import (
 	"context"
+	"fmt"
+
 	"github.com/shurcooL/githubv4"
 	"github.com/turbot/steampipe-plugin-github/github/models"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 )
 
+func gitHubMyRepositoryColumns() []*plugin.Column {
+	tableCols := []*plugin.Column{
+		{Name: "affiliation", Type: proto.ColumnType_STRING, Transform: transform.FromQual("affiliation"), Description: "Your affiliation with the repositories, one of OWNER, COLLABORATOR or ORGANIZATION_MEMBER. Without it the repositories of any affiliation are listed."},
+	}
+
+	return append(sharedRepositoryColumns(), tableCols...)
+}
+
 func tableGitHubMyRepository() *plugin.Table {
 	return &plugin.Table{
 		Name:        "github_my_repository",
@@ -14,12 +26,47 @@ func tableGitHubMyRepository() *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate:           tableGitHubMyRepositoryList,
 			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "affiliation", Require: plugin.Optional},
+				{Name: "visibility", Require: plugin.Optional},
+			},
 		},
-		Columns: sharedRepositoryColumns(),
+		Columns: gitHubMyRepositoryColumns(),
 	}
 }
 
 func tableGitHubMyRepositoryList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	quals := d.EqualsQuals
+
+	affiliations := []githubv4.RepositoryAffiliation{githubv4.RepositoryAffiliationCollaborator, githubv4.RepositoryAffiliationOwner, githubv4.RepositoryAffiliationOrganizationMember}
+	if quals["affiliation"] != nil {
+		affiliation := quals["affiliation"].GetStringValue()
+		switch affiliation {
+		case "OWNER", "COLLABORATOR", "ORGANIZATION_MEMBER":
+			affiliations = []githubv4.RepositoryAffiliation{githubv4.RepositoryAffiliation(affiliation)}
+		default:
+			plugin.Logger(ctx).Error("github_my_repository", "invalid filter", "affiliation", affiliation)
+			return nil, fmt.Errorf("invalid value for 'affiliation' can only filter for 'OWNER', 'COLLABORATOR' or 'ORGANIZATION_MEMBER' - you attempted to filter for '%s'", affiliation)
+		}
+	}
+
+	// The privacy argument has no internal value, internal repositories are
+	// private ones and are told apart by the visibility qual after the listing
+	privacy := (*githubv4.RepositoryPrivacy)(nil)
+	if quals["visibility"] != nil {
+		visibility := quals["visibility"].GetStringValue()
+		public, private := githubv4.RepositoryPrivacyPublic, githubv4.RepositoryPrivacyPrivate
+		switch visibility {
+		case "PUBLIC":
+			privacy = &public
+		case "PRIVATE", "INTERNAL":
+			privacy = &private
+		default:
+			plugin.Logger(ctx).Error("github_my_repository", "invalid filter", "visibility", visibility)
+			return nil, fmt.Errorf("invalid value for 'visibility' can only filter for 'PUBLIC', 'PRIVATE' or 'INTERNAL' - you attempted to filter for '%s'", visibility)
+		}
+	}
+
 	client := connectV4(ctx, d)
 
 	pageSize := adjustPageSize(50, d.QueryContext.Limit)
@@ -31,13 +78,15 @@ func tableGitHubMyRepositoryList(ctx context.Context, d *plugin.QueryData, h *pl
 				PageInfo   models.PageInfo
 				TotalCount int
 				Nodes      []models.Repository
-			} `graphql:"repositories(first: $pageSize, after: $cursor, affiliations: [COLLABORATOR, OWNER, ORGANIZATION_MEMBER], ownerAffiliations: [COLLABORATOR, OWNER, ORGANIZATION_MEMBER])"`
+			} `graphql:"repositories(first: $pageSize, after: $cursor, affiliations: $affiliations, ownerAffiliations: $affiliations, privacy: $privacy)"`
 		}
 	}
 
 	variables := map[string]interface{}{
-		"pageSize": githubv4.Int(pageSize),
-		"cursor":   (*githubv4.String)(nil),
+		"pageSize":     githubv4.Int(pageSize),
+		"cursor":       (*githubv4.String)(nil),
+		"affiliations": affiliations,
+		"privacy":      privacy,
 	}
 	appendRepoColumnIncludes(&variables, d.QueryContext.Columns)
 
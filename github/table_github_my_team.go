@@ -49,6 +49,7 @@ func tableGitHubMyTeamList(ctx context.Context, d *plugin.QueryData, h *plugin.H
 		"pageSize":    githubv4.Int(pageSize),
 		"cursor":      (*githubv4.String)(nil),
 	}
+	appendTeamColumnIncludes(&variables, d.QueryContext.Columns)
 
 	client := connectV4(ctx, d)
 
@@ -68,7 +69,7 @@ func tableGitHubMyTeamList(ctx context.Context, d *plugin.QueryData, h *plugin.H
 				break
 			}
 			if org.Teams.PageInfo.HasNextPage {
-				ts, err := getAdditionalTeams(ctx, client, org.Login, org.Teams.PageInfo.EndCursor)
+				ts, err := getAdditionalTeams(ctx, d, client, org.Login, org.Teams.PageInfo.EndCursor)
 				if err != nil {
 					plugin.Logger(ctx).Error("github_my_team", "api_error", err)
 					return nil, err
@@ -98,7 +99,7 @@ func tableGitHubMyTeamList(ctx context.Context, d *plugin.QueryData, h *plugin.H
 	return nil, nil
 }
 
-func getAdditionalTeams(ctx context.Context, client *githubv4.Client, org string, initialCursor githubv4.String) ([]models.TeamWithCounts, error) {
+func getAdditionalTeams(ctx context.Context, d *plugin.QueryData, client *githubv4.Client, org string, initialCursor githubv4.String) ([]models.TeamWithCounts, error) {
 	var query struct {
 		RateLimit    models.RateLimit
 		Organization struct {
@@ -114,6 +115,7 @@ func getAdditionalTeams(ctx context.Context, client *githubv4.Client, org string
 		"cursor":   githubv4.NewString(initialCursor),
 		"login":    githubv4.String(org),
 	}
+	appendTeamColumnIncludes(&variables, d.QueryContext.Columns)
 
 	var ts []models.TeamWithCounts
 	for {
@@ -0,0 +1,109 @@
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v55/github"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubNotification() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_notification",
+		Description: "Notifications of the authenticated user.",
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "all", Require: plugin.Optional, Operators: []string{"="}},
+				{Name: "participating", Require: plugin.Optional, Operators: []string{"="}},
+				{Name: "updated_at", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<="}},
+				{Name: "repository_full_name", Require: plugin.Optional},
+			},
+			Hydrate: tableGitHubNotificationList,
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ID"), Description: "Unique ID of the notification thread."},
+			{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Repository.FullName"), Description: "Full name of the repository the notification is for."},
+			{Name: "reason", Type: proto.ColumnType_STRING, Description: "The reason the notification was delivered, e.g. assign, author, comment, mention, review_requested or subscribed."},
+			{Name: "subject_type", Type: proto.ColumnType_STRING, Transform: transform.FromField("Subject.Type"), Description: "The type of the subject of the notification, e.g. Issue, PullRequest, Commit or Release."},
+			{Name: "subject_title", Type: proto.ColumnType_STRING, Transform: transform.FromField("Subject.Title"), Description: "The title of the subject of the notification."},
+			{Name: "unread", Type: proto.ColumnType_BOOL, Description: "If true, the notification has not been read."},
+			{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("UpdatedAt").Transform(convertTimestamp), Description: "Time when the notification was last updated."},
+			// Other columns
+			{Name: "all", Type: proto.ColumnType_BOOL, Transform: transform.FromQual("all"), Description: "If true, notifications which have been marked as read are included. Defaults to false."},
+			{Name: "participating", Type: proto.ColumnType_BOOL, Transform: transform.FromQual("participating"), Description: "If true, only notifications in which the user is directly participating or mentioned are included. Defaults to false."},
+			{Name: "last_read_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("LastReadAt").Transform(convertTimestamp), Description: "Time when the notification was last read."},
+			{Name: "subject_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("Subject.URL"), Description: "The API URL of the subject of the notification."},
+			{Name: "subject_latest_comment_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("Subject.LatestCommentURL"), Description: "The API URL of the latest comment on the subject of the notification."},
+			{Name: "url", Type: proto.ColumnType_STRING, Transform: transform.FromField("URL"), Description: "The API URL of the notification thread."},
+		},
+	}
+}
+
+func tableGitHubNotificationList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+
+	quals := d.EqualsQuals
+	opts := &github.NotificationListOptions{
+		All:           quals["all"].GetBoolValue(),
+		Participating: quals["participating"].GetBoolValue(),
+		ListOptions:   github.ListOptions{PerPage: 50},
+	}
+
+	if d.Quals["updated_at"] != nil {
+		for _, q := range d.Quals["updated_at"].Quals {
+			t := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case ">", ">=":
+				opts.Since = t
+			case "<", "<=":
+				opts.Before = t
+			}
+		}
+	}
+
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit < int64(opts.ListOptions.PerPage) {
+			opts.ListOptions.PerPage = int(*limit)
+		}
+	}
+
+	for {
+		var notifications []*github.Notification
+		var resp *github.Response
+		var err error
+		if quals["repository_full_name"] != nil {
+			owner, repo := parseRepoFullName(quals["repository_full_name"].GetStringValue())
+			notifications, resp, err = client.Activity.ListRepositoryNotifications(ctx, owner, repo, opts)
+		} else {
+			notifications, resp, err = client.Activity.ListNotifications(ctx, opts)
+		}
+		if err != nil {
+			plugin.Logger(ctx).Error("github_notification", "api_error", err)
+			return nil, err
+		}
+
+		for _, n := range notifications {
+			if n != nil {
+				d.StreamListItem(ctx, n)
+			}
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.ListOptions.Page = resp.NextPage
+	}
+
+	return nil, nil
+}
@@ -2,6 +2,7 @@ package github
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/google/go-github/v55/github"
@@ -77,6 +78,19 @@ func sharedOrganizationColumns() []*plugin.Column {
 		{Name: "has_organization_projects", Type: proto.ColumnType_BOOL, Description: "If true, the organization can use organization projects.", Hydrate: hydrateOrganizationDataFromV3},
 		{Name: "has_repository_projects", Type: proto.ColumnType_BOOL, Description: "If true, the organization can use repository projects.", Hydrate: hydrateOrganizationDataFromV3},
 		{Name: "web_commit_signoff_required", Type: proto.ColumnType_BOOL, Description: "If true, contributors are required to sign off on web-based commits for repositories in this organization.", Hydrate: hydrateOrganizationDataFromV3},
+		{Name: "members_can_create_public_pages", Type: proto.ColumnType_BOOL, Description: "If true, members can create public pages.", Hydrate: hydrateOrganizationDataFromV3},
+		{Name: "members_can_create_private_pages", Type: proto.ColumnType_BOOL, Description: "If true, members can create private pages.", Hydrate: hydrateOrganizationDataFromV3},
+		{Name: "advanced_security_enabled_for_new_repositories", Type: proto.ColumnType_BOOL, Description: "If true, GitHub Advanced Security is automatically enabled for new repositories.", Hydrate: hydrateOrganizationDataFromV3, Transform: transform.FromField("AdvancedSecurityEnabledForNewRepos")},
+		{Name: "dependabot_alerts_enabled_for_new_repositories", Type: proto.ColumnType_BOOL, Description: "If true, Dependabot alerts are automatically enabled for new repositories.", Hydrate: hydrateOrganizationDataFromV3, Transform: transform.FromField("DependabotAlertsEnabledForNewRepos")},
+		{Name: "dependabot_security_updates_enabled_for_new_repositories", Type: proto.ColumnType_BOOL, Description: "If true, Dependabot security updates are automatically enabled for new repositories.", Hydrate: hydrateOrganizationDataFromV3, Transform: transform.FromField("DependabotSecurityUpdatesEnabledForNewRepos")},
+		{Name: "dependency_graph_enabled_for_new_repositories", Type: proto.ColumnType_BOOL, Description: "If true, the dependency graph is automatically enabled for new repositories.", Hydrate: hydrateOrganizationDataFromV3, Transform: transform.FromField("DependencyGraphEnabledForNewRepos")},
+		{Name: "secret_scanning_enabled_for_new_repositories", Type: proto.ColumnType_BOOL, Description: "If true, secret scanning is automatically enabled for new repositories.", Hydrate: hydrateOrganizationDataFromV3, Transform: transform.FromField("SecretScanningEnabledForNewRepos")},
+		{Name: "secret_scanning_push_protection_enabled_for_new_repositories", Type: proto.ColumnType_BOOL, Description: "If true, secret scanning push protection is automatically enabled for new repositories.", Hydrate: hydrateOrganizationDataFromV3, Transform: transform.FromField("SecretScanningPushProtectionEnabledForNewRepos")},
+		{Name: "actions_enabled_repositories", Type: proto.ColumnType_STRING, Description: "The repositories GitHub Actions is enabled for, one of all, none or selected.", Hydrate: hydrateOrganizationActionsPermissions, Transform: transform.FromField("EnabledRepositories")},
+		{Name: "actions_allowed_actions", Type: proto.ColumnType_STRING, Description: "The actions and reusable workflows that are allowed to run, one of all, local_only or selected.", Hydrate: hydrateOrganizationActionsPermissions, Transform: transform.FromField("AllowedActions")},
+		{Name: "actions_selected_actions", Type: proto.ColumnType_JSON, Description: "The actions and reusable workflows that are allowed to run when actions_allowed_actions is selected.", Hydrate: hydrateOrganizationActionsAllowed, Transform: transform.FromValue()},
+		{Name: "actions_default_workflow_permissions", Type: proto.ColumnType_STRING, Description: "The default permissions granted to the GITHUB_TOKEN when running workflows, one of read or write.", Hydrate: hydrateOrganizationWorkflowPermissions, Transform: transform.FromField("DefaultWorkflowPermissions")},
+		{Name: "actions_can_approve_pull_request_reviews", Type: proto.ColumnType_BOOL, Description: "If true, GitHub Actions can approve pull requests.", Hydrate: hydrateOrganizationWorkflowPermissions, Transform: transform.FromField("CanApprovePullRequestReviews")},
 	}
 }
 
@@ -174,8 +188,7 @@ func hydrateOrganizationDataFromV3(ctx context.Context, d *plugin.QueryData, h *
 	org := h.Item.(models.OrganizationWithCounts)
 	login := org.Login
 
-	client := connect(ctx, d)
-	organization, _, err := client.Organizations.Get(ctx, login)
+	organization, err := getOrganizationV3(ctx, d, login)
 	if err != nil {
 		plugin.Logger(ctx).Error("getOrganizationDetailV3", err)
 		return nil, err
@@ -183,3 +196,61 @@ func hydrateOrganizationDataFromV3(ctx context.Context, d *plugin.QueryData, h *
 
 	return organization, nil
 }
+
+// The Actions permissions of an organization can only be read by its owners, for other users they are null
+func hydrateOrganizationActionsPermissions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	org := h.Item.(models.OrganizationWithCounts)
+
+	client := connect(ctx, d)
+	permissions, _, err := client.Organizations.GetActionsPermissions(ctx, org.Login)
+	if err != nil {
+		if isNotFoundError([]string{"403", "404"})(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("github_organization.hydrateOrganizationActionsPermissions", "api_error", err)
+		return nil, err
+	}
+	return permissions, nil
+}
+
+func hydrateOrganizationActionsAllowed(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	org := h.Item.(models.OrganizationWithCounts)
+
+	client := connect(ctx, d)
+	allowed, _, err := client.Organizations.GetActionsAllowed(ctx, org.Login)
+	if err != nil {
+		// The API responds with 409 Conflict unless the allowed actions policy is set to selected
+		if isNotFoundError([]string{"403", "404", "409"})(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("github_organization.hydrateOrganizationActionsAllowed", "api_error", err)
+		return nil, err
+	}
+	return allowed, nil
+}
+
+type organizationWorkflowPermissions struct {
+	DefaultWorkflowPermissions   *string `json:"default_workflow_permissions,omitempty"`
+	CanApprovePullRequestReviews *bool   `json:"can_approve_pull_request_reviews,omitempty"`
+}
+
+func hydrateOrganizationWorkflowPermissions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	org := h.Item.(models.OrganizationWithCounts)
+
+	// go-github does not support the default workflow permissions endpoint yet
+	client := connect(ctx, d)
+	req, err := client.NewRequest("GET", fmt.Sprintf("orgs/%s/actions/permissions/workflow", org.Login), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var permissions organizationWorkflowPermissions
+	if _, err := client.Do(ctx, req, &permissions); err != nil {
+		if isNotFoundError([]string{"403", "404"})(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("github_organization.hydrateOrganizationWorkflowPermissions", "api_error", err)
+		return nil, err
+	}
+	return permissions, nil
+}
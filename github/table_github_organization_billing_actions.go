@@ -0,0 +1,46 @@
+package github
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubOrganizationBillingActions() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_organization_billing_actions",
+		Description: "GitHub Actions usage of the given organization in the current billing cycle.",
+		List: &plugin.ListConfig{
+			KeyColumns:        plugin.SingleColumn("organization"),
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubOrganizationBillingActionsList,
+		},
+		Columns: []*plugin.Column{
+			{Name: "organization", Type: proto.ColumnType_STRING, Transform: transform.FromQual("organization"), Description: "The organization the usage is for."},
+			{Name: "total_minutes_used", Type: proto.ColumnType_DOUBLE, Description: "The number of minutes used by GitHub-hosted runners, with multipliers applied for Windows and macOS runners."},
+			{Name: "total_paid_minutes_used", Type: proto.ColumnType_DOUBLE, Description: "The number of minutes which exceeded the included minutes and are billed."},
+			{Name: "included_minutes", Type: proto.ColumnType_DOUBLE, Description: "The number of minutes included in the plan of the organization."},
+			{Name: "minutes_used_breakdown", Type: proto.ColumnType_JSON, Description: "The number of minutes used, keyed by the runner type, e.g. UBUNTU, WINDOWS or MACOS."},
+		},
+	}
+}
+
+func tableGitHubOrganizationBillingActionsList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+
+	org := d.EqualsQuals["organization"].GetStringValue()
+
+	billing, _, err := client.Billing.GetActionsBillingOrg(ctx, org)
+	if err != nil {
+		plugin.Logger(ctx).Error("github_organization_billing_actions", "api_error", err)
+		return nil, err
+	}
+
+	if billing != nil {
+		d.StreamListItem(ctx, billing)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,45 @@
+package github
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubOrganizationBillingPackages() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_organization_billing_packages",
+		Description: "GitHub Packages data transfer of the given organization in the current billing cycle.",
+		List: &plugin.ListConfig{
+			KeyColumns:        plugin.SingleColumn("organization"),
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubOrganizationBillingPackagesList,
+		},
+		Columns: []*plugin.Column{
+			{Name: "organization", Type: proto.ColumnType_STRING, Transform: transform.FromQual("organization"), Description: "The organization the usage is for."},
+			{Name: "total_gigabytes_bandwidth_used", Type: proto.ColumnType_INT, Description: "The amount of data transferred out of GitHub Packages, in gigabytes."},
+			{Name: "total_paid_gigabytes_bandwidth_used", Type: proto.ColumnType_INT, Description: "The amount of data transferred which exceeded the included bandwidth and is billed, in gigabytes."},
+			{Name: "included_gigabytes_bandwidth", Type: proto.ColumnType_DOUBLE, Description: "The amount of data transfer included in the plan of the organization, in gigabytes."},
+		},
+	}
+}
+
+func tableGitHubOrganizationBillingPackagesList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+
+	org := d.EqualsQuals["organization"].GetStringValue()
+
+	billing, _, err := client.Billing.GetPackagesBillingOrg(ctx, org)
+	if err != nil {
+		plugin.Logger(ctx).Error("github_organization_billing_packages", "api_error", err)
+		return nil, err
+	}
+
+	if billing != nil {
+		d.StreamListItem(ctx, billing)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,45 @@
+package github
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubOrganizationBillingSharedStorage() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_organization_billing_shared_storage",
+		Description: "Storage used by GitHub Actions artifacts and GitHub Packages in the given organization in the current billing cycle.",
+		List: &plugin.ListConfig{
+			KeyColumns:        plugin.SingleColumn("organization"),
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubOrganizationBillingSharedStorageList,
+		},
+		Columns: []*plugin.Column{
+			{Name: "organization", Type: proto.ColumnType_STRING, Transform: transform.FromQual("organization"), Description: "The organization the usage is for."},
+			{Name: "days_left_in_billing_cycle", Type: proto.ColumnType_INT, Description: "The number of days left in the current billing cycle."},
+			{Name: "estimated_paid_storage_for_month", Type: proto.ColumnType_DOUBLE, Description: "The estimated storage which exceeds the included storage and will be billed for the month, in gigabytes."},
+			{Name: "estimated_storage_for_month", Type: proto.ColumnType_DOUBLE, Description: "The estimated storage used for the month, in gigabytes."},
+		},
+	}
+}
+
+func tableGitHubOrganizationBillingSharedStorageList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+
+	org := d.EqualsQuals["organization"].GetStringValue()
+
+	billing, _, err := client.Billing.GetStorageBillingOrg(ctx, org)
+	if err != nil {
+		plugin.Logger(ctx).Error("github_organization_billing_shared_storage", "api_error", err)
+		return nil, err
+	}
+
+	if billing != nil {
+		d.StreamListItem(ctx, billing)
+	}
+
+	return nil, nil
+}
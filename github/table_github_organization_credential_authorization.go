@@ -0,0 +1,81 @@
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v55/github"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubOrganizationCredentialAuthorization() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_organization_credential_authorization",
+		Description: "Credentials authorized for SAML single sign-on in the given organization.",
+		List: &plugin.ListConfig{
+			KeyColumns:        plugin.SingleColumn("organization"),
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubOrganizationCredentialAuthorizationList,
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "organization", Type: proto.ColumnType_STRING, Transform: transform.FromQual("organization"), Description: "The organization the credential is authorized for."},
+			{Name: "login", Type: proto.ColumnType_STRING, Description: "The login of the user who owns the credential."},
+			{Name: "credential_id", Type: proto.ColumnType_INT, Transform: transform.FromField("CredentialID"), Description: "Unique ID of the credential authorization."},
+			{Name: "credential_type", Type: proto.ColumnType_STRING, Description: "The type of the credential, e.g. personal access token or SSH key."},
+			{Name: "scopes", Type: proto.ColumnType_JSON, Description: "The OAuth scopes granted to the token, for personal access tokens."},
+			{Name: "credential_authorized_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("CredentialAuthorizedAt").Transform(convertTimestamp), Description: "Time when the credential was authorized for use with the organization."},
+			{Name: "credential_accessed_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("CredentialAccessedAt").Transform(convertTimestamp), Description: "Time when the credential was last used to access the organization. Returns null if the credential has not been used in the past year."},
+			// Other columns
+			{Name: "token_last_eight", Type: proto.ColumnType_STRING, Description: "The last eight characters of the token, for personal access tokens."},
+			{Name: "fingerprint", Type: proto.ColumnType_STRING, Description: "The fingerprint of the key, for SSH keys."},
+			{Name: "authorized_credential_id", Type: proto.ColumnType_INT, Transform: transform.FromField("AuthorizedCredentialID"), Description: "The ID of the underlying token or key."},
+			{Name: "authorized_credential_title", Type: proto.ColumnType_STRING, Description: "The title given to the key, for SSH keys."},
+			{Name: "authorized_credential_note", Type: proto.ColumnType_STRING, Description: "The note given to the token, for personal access tokens."},
+			{Name: "authorized_credential_expires_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("AuthorizedCredentialExpiresAt").Transform(convertTimestamp), Description: "Time when the token expires, for personal access tokens with an expiry."},
+		},
+	}
+}
+
+func tableGitHubOrganizationCredentialAuthorizationList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+
+	org := d.EqualsQuals["organization"].GetStringValue()
+	opts := &github.ListOptions{PerPage: 100}
+
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit < int64(opts.PerPage) {
+			opts.PerPage = int(*limit)
+		}
+	}
+
+	for {
+		credentials, resp, err := client.Organizations.ListCredentialAuthorizations(ctx, org, opts)
+		if err != nil {
+			plugin.Logger(ctx).Error("github_organization_credential_authorization", "api_error", err)
+			return nil, err
+		}
+
+		for _, c := range credentials {
+			if c != nil {
+				d.StreamListItem(ctx, c)
+			}
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return nil, nil
+}
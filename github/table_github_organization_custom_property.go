@@ -0,0 +1,74 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// The go-github client does not support custom properties yet, so they are fetched with raw requests
+type organizationCustomProperty struct {
+	PropertyName     string      `json:"property_name"`
+	ValueType        string      `json:"value_type"`
+	Required         bool        `json:"required"`
+	DefaultValue     interface{} `json:"default_value"`
+	Description      string      `json:"description"`
+	AllowedValues    []string    `json:"allowed_values"`
+	ValuesEditableBy string      `json:"values_editable_by"`
+}
+
+func tableGitHubOrganizationCustomProperty() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_organization_custom_property",
+		Description: "Custom property definitions for the repositories of the given organization.",
+		List: &plugin.ListConfig{
+			KeyColumns:        plugin.SingleColumn("organization"),
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubOrganizationCustomPropertyList,
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "organization", Type: proto.ColumnType_STRING, Transform: transform.FromQual("organization"), Description: "The organization the custom property is defined in."},
+			{Name: "property_name", Type: proto.ColumnType_STRING, Description: "The name of the property."},
+			{Name: "value_type", Type: proto.ColumnType_STRING, Description: "The type of the value of the property, one of string, single_select, multi_select or true_false."},
+			{Name: "required", Type: proto.ColumnType_BOOL, Description: "If true, every repository must have a value for the property."},
+			{Name: "default_value", Type: proto.ColumnType_JSON, Description: "The value of the property for repositories which do not set one."},
+			// Other columns
+			{Name: "description", Type: proto.ColumnType_STRING, Transform: transform.FromField("Description").NullIfZero(), Description: "The description of the property."},
+			{Name: "allowed_values", Type: proto.ColumnType_JSON, Description: "The values the property can take, for select properties."},
+			{Name: "values_editable_by", Type: proto.ColumnType_STRING, Transform: transform.FromField("ValuesEditableBy").NullIfZero(), Description: "Who can edit the values of the property, either org_actors or org_and_repo_actors."},
+		},
+	}
+}
+
+func tableGitHubOrganizationCustomPropertyList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+
+	org := d.EqualsQuals["organization"].GetStringValue()
+
+	req, err := client.NewRequest("GET", fmt.Sprintf("orgs/%v/properties/schema", org), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var properties []*organizationCustomProperty
+	_, err = client.Do(ctx, req, &properties)
+	if err != nil {
+		plugin.Logger(ctx).Error("github_organization_custom_property", "api_error", err)
+		return nil, err
+	}
+
+	for _, p := range properties {
+		d.StreamListItem(ctx, p)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
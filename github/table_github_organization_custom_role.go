@@ -0,0 +1,196 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v55/github"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type organizationCustomRole struct {
+	RoleType    string            `json:"-"`
+	ID          int64             `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	BaseRole    string            `json:"base_role"`
+	Source      string            `json:"source"`
+	Permissions []string          `json:"permissions"`
+	CreatedAt   *github.Timestamp `json:"created_at"`
+	UpdatedAt   *github.Timestamp `json:"updated_at"`
+}
+
+// The go-github client does not support organization roles yet, so they are listed with raw requests
+type organizationRoles struct {
+	TotalCount int                       `json:"total_count"`
+	Roles      []*organizationCustomRole `json:"roles"`
+}
+
+func tableGitHubOrganizationCustomRole() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_organization_custom_role",
+		Description: "Custom repository roles and organization roles defined in the given organization.",
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "organization", Require: plugin.Required},
+				{Name: "role_type", Require: plugin.Optional},
+			},
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubOrganizationCustomRoleList,
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "organization", Type: proto.ColumnType_STRING, Transform: transform.FromQual("organization"), Description: "The organization the role is defined in."},
+			{Name: "role_type", Type: proto.ColumnType_STRING, Description: "The type of the role, either repository for custom repository roles or organization for organization roles."},
+			{Name: "id", Type: proto.ColumnType_INT, Transform: transform.FromField("ID"), Description: "Unique ID of the role."},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the role."},
+			{Name: "base_role", Type: proto.ColumnType_STRING, Transform: transform.FromField("BaseRole").NullIfZero(), Description: "The system role the role inherits permissions from, e.g. read, triage, write or maintain."},
+			{Name: "permissions", Type: proto.ColumnType_JSON, Description: "The permissions the role grants in addition to those of its base role."},
+			// Other columns
+			{Name: "description", Type: proto.ColumnType_STRING, Transform: transform.FromField("Description").NullIfZero(), Description: "The description of the role."},
+			{Name: "source", Type: proto.ColumnType_STRING, Transform: transform.FromField("Source").NullIfZero(), Description: "Where an organization role is defined, one of Organization, Enterprise or Predefined."},
+			{Name: "assigned_teams", Type: proto.ColumnType_JSON, Hydrate: tableGitHubOrganizationCustomRoleTeams, Transform: transform.FromValue(), Description: "The slugs of the teams assigned an organization role. Returns null for custom repository roles."},
+			{Name: "assigned_users", Type: proto.ColumnType_JSON, Hydrate: tableGitHubOrganizationCustomRoleUsers, Transform: transform.FromValue(), Description: "The logins of the users assigned an organization role. Returns null for custom repository roles."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("CreatedAt").Transform(convertTimestamp), Description: "Time when an organization role was created."},
+			{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("UpdatedAt").Transform(convertTimestamp), Description: "Time when an organization role was last updated."},
+		},
+	}
+}
+
+func tableGitHubOrganizationCustomRoleList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+
+	quals := d.EqualsQuals
+	org := quals["organization"].GetStringValue()
+	roleType := quals["role_type"].GetStringValue()
+
+	if roleType == "" || roleType == "repository" {
+		result, _, err := client.Organizations.ListCustomRepoRoles(ctx, org)
+		if err != nil {
+			plugin.Logger(ctx).Error("github_organization_custom_role", "api_error", err)
+			return nil, err
+		}
+
+		for _, r := range result.CustomRepoRoles {
+			d.StreamListItem(ctx, &organizationCustomRole{
+				RoleType:    "repository",
+				ID:          r.GetID(),
+				Name:        r.GetName(),
+				Description: r.GetDescription(),
+				BaseRole:    r.GetBaseRole(),
+				Permissions: r.Permissions,
+			})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	if roleType == "" || roleType == "organization" {
+		req, err := client.NewRequest("GET", fmt.Sprintf("orgs/%v/organization-roles", org), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		result := new(organizationRoles)
+		_, err = client.Do(ctx, req, result)
+		if err != nil {
+			plugin.Logger(ctx).Error("github_organization_custom_role", "api_error", err)
+			return nil, err
+		}
+
+		for _, r := range result.Roles {
+			r.RoleType = "organization"
+			d.StreamListItem(ctx, r)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// organizationRoleAssignee holds the identifying field of a team or user assigned an organization role
+type organizationRoleAssignee struct {
+	Login string `json:"login"`
+	Slug  string `json:"slug"`
+}
+
+func tableGitHubOrganizationCustomRoleTeams(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	role := h.Item.(*organizationCustomRole)
+	if role.RoleType != "organization" {
+		return nil, nil
+	}
+
+	teams, err := listOrganizationRoleAssignees(ctx, d, role.ID, "teams")
+	if err != nil {
+		plugin.Logger(ctx).Error("github_organization_custom_role.tableGitHubOrganizationCustomRoleTeams", "api_error", err)
+		return nil, err
+	}
+
+	slugs := []string{}
+	for _, t := range teams {
+		slugs = append(slugs, t.Slug)
+	}
+
+	return slugs, nil
+}
+
+func tableGitHubOrganizationCustomRoleUsers(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	role := h.Item.(*organizationCustomRole)
+	if role.RoleType != "organization" {
+		return nil, nil
+	}
+
+	users, err := listOrganizationRoleAssignees(ctx, d, role.ID, "users")
+	if err != nil {
+		plugin.Logger(ctx).Error("github_organization_custom_role.tableGitHubOrganizationCustomRoleUsers", "api_error", err)
+		return nil, err
+	}
+
+	logins := []string{}
+	for _, u := range users {
+		logins = append(logins, u.Login)
+	}
+
+	return logins, nil
+}
+
+// listOrganizationRoleAssignees lists the teams or users, depending on kind, assigned an organization role
+func listOrganizationRoleAssignees(ctx context.Context, d *plugin.QueryData, roleID int64, kind string) ([]organizationRoleAssignee, error) {
+	client := connect(ctx, d)
+	org := d.EqualsQuals["organization"].GetStringValue()
+	page := 1
+
+	var assignees []organizationRoleAssignee
+	for {
+		req, err := client.NewRequest("GET", fmt.Sprintf("orgs/%v/organization-roles/%v/%v?per_page=100&page=%v", org, roleID, kind, page), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var result []organizationRoleAssignee
+		resp, err := client.Do(ctx, req, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		assignees = append(assignees, result...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		page = resp.NextPage
+	}
+
+	return assignees, nil
+}
@@ -13,7 +13,9 @@ func gitHubOrganizationExternalIdentityColumns() []*plugin.Column {
 	return []*plugin.Column{
 		{Name: "organization", Type: proto.ColumnType_STRING, Description: "The organization the external identity is associated with.", Transform: transform.FromQual("organization")},
 		{Name: "guid", Type: proto.ColumnType_STRING, Description: "Guid identifier for the external identity.", Transform: transform.FromField("Guid")},
-		{Name: "user_login", Type: proto.ColumnType_STRING, Description: "The GitHub user login.", Transform: transform.FromField("User.Login")},
+		{Name: "user_login", Type: proto.ColumnType_STRING, Description: "The GitHub user login.", Transform: transform.FromField("User.Login").NullIfZero()},
+		{Name: "saml_name_id", Type: proto.ColumnType_STRING, Description: "The SAML NameID of the external identity, usually the user's email address at the identity provider.", Transform: transform.FromField("SamlIdentity.NameId").NullIfZero()},
+		{Name: "scim_username", Type: proto.ColumnType_STRING, Description: "The username of the SCIM identity provisioned for the user.", Transform: transform.FromField("ScimIdentity.Username").NullIfZero()},
 		{Name: "user_detail", Type: proto.ColumnType_JSON, Description: "The GitHub user details.", Transform: transform.FromField("User")},
 		{Name: "saml_identity", Type: proto.ColumnType_JSON, Description: "The external SAML identity."},
 		{Name: "scim_identity", Type: proto.ColumnType_JSON, Description: "The external SCIM identity."},
@@ -24,7 +26,7 @@ func gitHubOrganizationExternalIdentityColumns() []*plugin.Column {
 func tableGitHubOrganizationExternalIdentity() *plugin.Table {
 	return &plugin.Table{
 		Name:        "github_organization_external_identity",
-		Description: "GitHub members for a given organization. GitHub Users are user accounts in GitHub.",
+		Description: "SAML and SCIM external identities linked to the members of a given organization.",
 		List: &plugin.ListConfig{
 			KeyColumns: []*plugin.KeyColumn{
 				{
@@ -67,9 +69,9 @@ func tableGitHubOrganizationExternalIdentityList(ctx context.Context, d *plugin.
 
 	for {
 		err := client.Query(ctx, &query, variables)
-		plugin.Logger(ctx).Debug(rateLimitLogString("github_pull_request_comment", &query.RateLimit))
+		plugin.Logger(ctx).Debug(rateLimitLogString("github_organization_external_identity", &query.RateLimit))
 		if err != nil {
-			plugin.Logger(ctx).Error("github_pull_request_comment", "api_error", err)
+			plugin.Logger(ctx).Error("github_organization_external_identity", "api_error", err)
 			return nil, err
 		}
 
@@ -90,3 +92,48 @@ func tableGitHubOrganizationExternalIdentityList(ctx context.Context, d *plugin.
 
 	return nil, nil
 }
+
+// listOrganizationExternalIdentitiesByLogin returns the external identities of the organization keyed by the login of
+// the linked GitHub user, identities which are not linked to a user are skipped.
+func listOrganizationExternalIdentitiesByLogin(ctx context.Context, d *plugin.QueryData, org string) (map[string]models.OrganizationExternalIdentity, error) {
+	var query struct {
+		RateLimit    models.RateLimit
+		Organization struct {
+			SamlIdentityProvider struct {
+				ExternalIdentities struct {
+					PageInfo models.PageInfo
+					Nodes    []models.OrganizationExternalIdentity
+				} `graphql:"externalIdentities(first: $pageSize, after: $cursor)"`
+			}
+		} `graphql:"organization(login: $login)"`
+	}
+
+	variables := map[string]interface{}{
+		"login":    githubv4.String(org),
+		"pageSize": githubv4.Int(100),
+		"cursor":   (*githubv4.String)(nil),
+	}
+
+	identities := map[string]models.OrganizationExternalIdentity{}
+	client := connectV4(ctx, d)
+	for {
+		err := client.Query(ctx, &query, variables)
+		plugin.Logger(ctx).Debug(rateLimitLogString("github_organization_external_identity", &query.RateLimit))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, eid := range query.Organization.SamlIdentityProvider.ExternalIdentities.Nodes {
+			if eid.User.Login != "" {
+				identities[eid.User.Login] = eid
+			}
+		}
+
+		if !query.Organization.SamlIdentityProvider.ExternalIdentities.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Organization.SamlIdentityProvider.ExternalIdentities.PageInfo.EndCursor)
+	}
+
+	return identities, nil
+}
@@ -0,0 +1,142 @@
+package github
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/google/go-github/v55/github"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type organizationInvitation struct {
+	*github.Invitation
+	Status string
+}
+
+func tableGitHubOrganizationInvitation() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_organization_invitation",
+		Description: "Pending and failed invitations to join the given organization.",
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "organization", Require: plugin.Required},
+				{Name: "status", Require: plugin.Optional},
+			},
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubOrganizationInvitationList,
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "organization", Type: proto.ColumnType_STRING, Transform: transform.FromQual("organization"), Description: "The organization the invitation is for."},
+			{Name: "id", Type: proto.ColumnType_INT, Transform: transform.FromField("ID"), Description: "Unique ID of the invitation."},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "The status of the invitation, either pending or failed."},
+			{Name: "login", Type: proto.ColumnType_STRING, Description: "The login name of the user invited, if the invitation was sent to a GitHub account."},
+			{Name: "email", Type: proto.ColumnType_STRING, Description: "The email address invited, if the invitation was sent by email."},
+			{Name: "role", Type: proto.ColumnType_STRING, Description: "The role the invitee will have in the organization, one of direct_member, admin, billing_manager, hiring_manager or reinstate."},
+			{Name: "inviter_login", Type: proto.ColumnType_STRING, Transform: transform.FromField("Inviter.Login"), Description: "The login name of the user who sent the invitation."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("CreatedAt").Transform(convertTimestamp), Description: "Time when the invitation was created."},
+			// Other columns
+			{Name: "node_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("NodeID"), Description: "The node ID of the invitation."},
+			{Name: "team_count", Type: proto.ColumnType_INT, Description: "The number of teams the invitee will be added to."},
+			{Name: "teams", Type: proto.ColumnType_JSON, Hydrate: tableGitHubOrganizationInvitationTeams, Transform: transform.FromValue(), Description: "The slugs of the teams the invitee will be added to."},
+			{Name: "failed_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("FailedAt").Transform(convertTimestamp), Description: "Time when the invitation failed."},
+			{Name: "failed_reason", Type: proto.ColumnType_STRING, Description: "The reason the invitation failed, e.g. because it expired."},
+			{Name: "inviter", Type: proto.ColumnType_JSON, Transform: transform.FromField("Inviter").NullIfZero(), Description: "The user who sent the invitation."},
+		},
+	}
+}
+
+func tableGitHubOrganizationInvitationList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+
+	quals := d.EqualsQuals
+	org := quals["organization"].GetStringValue()
+
+	// Pending and failed invitations are listed by separate endpoints
+	listers := map[string]func(context.Context, string, *github.ListOptions) ([]*github.Invitation, *github.Response, error){
+		"pending": client.Organizations.ListPendingOrgInvitations,
+		"failed":  client.Organizations.ListFailedOrgInvitations,
+	}
+	statuses := []string{"pending", "failed"}
+	if quals["status"] != nil {
+		statuses = []string{quals["status"].GetStringValue()}
+	}
+
+	for _, status := range statuses {
+		list, ok := listers[status]
+		if !ok {
+			continue
+		}
+
+		opts := &github.ListOptions{PerPage: 100}
+
+		limit := d.QueryContext.Limit
+		if limit != nil {
+			if *limit < int64(opts.PerPage) {
+				opts.PerPage = int(*limit)
+			}
+		}
+
+		for {
+			invitations, resp, err := list(ctx, org, opts)
+			if err != nil {
+				plugin.Logger(ctx).Error("github_organization_invitation", "api_error", err)
+				return nil, err
+			}
+
+			for _, i := range invitations {
+				if i != nil {
+					d.StreamListItem(ctx, organizationInvitation{Invitation: i, Status: status})
+				}
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+
+			if resp.NextPage == 0 {
+				break
+			}
+
+			opts.Page = resp.NextPage
+		}
+	}
+
+	return nil, nil
+}
+
+func tableGitHubOrganizationInvitationTeams(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	invitation := h.Item.(organizationInvitation)
+	if invitation.GetTeamCount() == 0 {
+		return []string{}, nil
+	}
+
+	client := connect(ctx, d)
+	org := d.EqualsQuals["organization"].GetStringValue()
+	opts := &github.ListOptions{PerPage: 100}
+
+	var slugs []string
+	for {
+		teams, resp, err := client.Organizations.ListOrgInvitationTeams(ctx, org, strconv.FormatInt(invitation.GetID(), 10), opts)
+		if err != nil {
+			plugin.Logger(ctx).Error("github_organization_invitation.tableGitHubOrganizationInvitationTeams", "api_error", err)
+			return nil, err
+		}
+
+		for _, t := range teams {
+			slugs = append(slugs, t.GetSlug())
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return slugs, nil
+}
@@ -0,0 +1,114 @@
+package github
+
+import (
+	"context"
+	"strings"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/turbot/steampipe-plugin-github/github/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type organizationIpAllowListEntry struct {
+	models.IpAllowListEntry
+	IpAllowListEnabledSetting                 githubv4.IpAllowListEnabledSettingValue
+	IpAllowListForInstalledAppsEnabledSetting githubv4.IpAllowListForInstalledAppsEnabledSettingValue
+}
+
+func tableGitHubOrganizationIpAllowList() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_organization_ip_allow_list",
+		Description: "IP allow list entries for the given organization.",
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "organization", Require: plugin.Required},
+			},
+			Hydrate: tableGitHubOrganizationIpAllowListList,
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "organization", Type: proto.ColumnType_STRING, Transform: transform.FromQual("organization"), Description: "The organization the IP allow list entry belongs to."},
+			{Name: "allow_list_value", Type: proto.ColumnType_CIDR, Description: "A single IP address or range of IP addresses in CIDR notation."},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the IP allow list entry."},
+			{Name: "is_active", Type: proto.ColumnType_BOOL, Description: "If true, the entry is currently active."},
+			// Other columns
+			{Name: "node_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("NodeId"), Description: "The node ID of the IP allow list entry."},
+			{Name: "ip_allow_list_enabled", Type: proto.ColumnType_BOOL, Transform: transform.FromField("IpAllowListEnabledSetting").Transform(isIpAllowListSettingEnabled), Description: "If true, the IP allow list is enforced for the organization."},
+			{Name: "ip_allow_list_for_installed_apps_enabled", Type: proto.ColumnType_BOOL, Transform: transform.FromField("IpAllowListForInstalledAppsEnabledSetting").Transform(isIpAllowListSettingEnabled), Description: "If true, the IP allow list configuration of installed GitHub Apps is applied to the organization."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("CreatedAt").NullIfZero().Transform(convertTimestamp), Description: "Timestamp when the entry was created."},
+			{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("UpdatedAt").NullIfZero().Transform(convertTimestamp), Description: "Timestamp when the entry was last updated."},
+		},
+	}
+}
+
+func tableGitHubOrganizationIpAllowListList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	quals := d.EqualsQuals
+	org := quals["organization"].GetStringValue()
+
+	pageSize := adjustPageSize(100, d.QueryContext.Limit)
+
+	var query struct {
+		RateLimit    models.RateLimit
+		Organization struct {
+			IpAllowListEnabledSetting                 githubv4.IpAllowListEnabledSettingValue
+			IpAllowListForInstalledAppsEnabledSetting githubv4.IpAllowListForInstalledAppsEnabledSettingValue
+			IpAllowListEntries                        struct {
+				PageInfo models.PageInfo
+				Nodes    []models.IpAllowListEntry
+			} `graphql:"ipAllowListEntries(first: $pageSize, after: $cursor)"`
+		} `graphql:"organization(login: $login)"`
+	}
+
+	variables := map[string]interface{}{
+		"login":    githubv4.String(org),
+		"pageSize": githubv4.Int(pageSize),
+		"cursor":   (*githubv4.String)(nil),
+	}
+
+	client := connectV4(ctx, d)
+
+	for {
+		err := client.Query(ctx, &query, variables)
+		plugin.Logger(ctx).Debug(rateLimitLogString("github_organization_ip_allow_list", &query.RateLimit))
+		if err != nil {
+			plugin.Logger(ctx).Error("github_organization_ip_allow_list", "api_error", err)
+			if strings.Contains(err.Error(), "Could not resolve to an Organization with the login of") {
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		for _, entry := range query.Organization.IpAllowListEntries.Nodes {
+			d.StreamListItem(ctx, organizationIpAllowListEntry{
+				IpAllowListEntry:                          entry,
+				IpAllowListEnabledSetting:                 query.Organization.IpAllowListEnabledSetting,
+				IpAllowListForInstalledAppsEnabledSetting: query.Organization.IpAllowListForInstalledAppsEnabledSetting,
+			})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if !query.Organization.IpAllowListEntries.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Organization.IpAllowListEntries.PageInfo.EndCursor)
+	}
+
+	return nil, nil
+}
+
+func isIpAllowListSettingEnabled(_ context.Context, input *transform.TransformData) (interface{}, error) {
+	switch v := input.Value.(type) {
+	case githubv4.IpAllowListEnabledSettingValue:
+		return v == githubv4.IpAllowListEnabledSettingValueEnabled, nil
+	case githubv4.IpAllowListForInstalledAppsEnabledSettingValue:
+		return v == githubv4.IpAllowListForInstalledAppsEnabledSettingValueEnabled, nil
+	}
+	return nil, nil
+}
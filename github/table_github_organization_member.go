@@ -3,6 +3,7 @@ package github
 import (
 	"context"
 	"github.com/turbot/steampipe-plugin-github/github/models"
+	"slices"
 	"strings"
 
 	"github.com/shurcooL/githubv4"
@@ -17,6 +18,8 @@ func gitHubOrganizationMemberColumns() []*plugin.Column {
 		{Name: "organization", Type: proto.ColumnType_STRING, Description: "The organization the member is associated with.", Transform: transform.FromQual("organization")},
 		{Name: "role", Type: proto.ColumnType_STRING, Description: "The role this user has in the organization. Returns null if information is not available to viewer."},
 		{Name: "has_two_factor_enabled", Type: proto.ColumnType_BOOL, Description: "Whether the organization member has two factor enabled or not. Returns null if information is not available to viewer."},
+		{Name: "saml_name_id", Type: proto.ColumnType_STRING, Description: "The SAML NameID the member's account is linked to. Returns null if the organization does not use SAML single sign-on or the member has not linked an identity.", Transform: transform.FromField("ExternalIdentity.SamlIdentity.NameId")},
+		{Name: "saml_identity", Type: proto.ColumnType_JSON, Description: "The SAML identity the member's account is linked to. Returns null if the organization does not use SAML single sign-on or the member has not linked an identity.", Transform: transform.FromField("ExternalIdentity.SamlIdentity").NullIfZero()},
 	}
 
 	return append(tableCols, sharedUserColumns()...)
@@ -28,6 +31,11 @@ type memberWithRole struct {
 	Node                models.User
 }
 
+type organizationMember struct {
+	memberWithRole
+	ExternalIdentity *models.OrganizationExternalIdentity
+}
+
 func tableGitHubOrganizationMember() *plugin.Table {
 	return &plugin.Table{
 		Name:        "github_organization_member",
@@ -70,6 +78,20 @@ func tableGitHubOrganizationMemberList(ctx context.Context, d *plugin.QueryData,
 		"cursor":   (*githubv4.String)(nil), // Null after argument to get first page.
 	}
 
+	// SAML identities are listed separately from the members, so only fetch them if required
+	var externalIdentities map[string]models.OrganizationExternalIdentity
+	if slices.Contains(d.QueryContext.Columns, "saml_name_id") || slices.Contains(d.QueryContext.Columns, "saml_identity") {
+		var err error
+		externalIdentities, err = listOrganizationExternalIdentitiesByLogin(ctx, d, org)
+		if err != nil {
+			plugin.Logger(ctx).Error("github_organization_member", "api_error", err)
+			if strings.Contains(err.Error(), "Could not resolve to an Organization with the login of") {
+				return nil, nil
+			}
+			return nil, err
+		}
+	}
+
 	for {
 		err := client.Query(ctx, &query, variables)
 		plugin.Logger(ctx).Debug(rateLimitLogString("github_organization_member", &query.RateLimit))
@@ -82,7 +104,11 @@ func tableGitHubOrganizationMemberList(ctx context.Context, d *plugin.QueryData,
 		}
 
 		for _, member := range query.Organization.MembersWithRole.Edges {
-			d.StreamListItem(ctx, member)
+			row := organizationMember{memberWithRole: member}
+			if eid, ok := externalIdentities[member.Node.Login]; ok {
+				row.ExternalIdentity = &eid
+			}
+			d.StreamListItem(ctx, row)
 
 			// Context can be cancelled due to manual cancellation or the limit has been hit
 			if d.RowsRemaining(ctx) == 0 {
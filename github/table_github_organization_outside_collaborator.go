@@ -0,0 +1,261 @@
+package github
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/go-github/v55/github"
+	"github.com/shurcooL/githubv4"
+	"github.com/turbot/steampipe-plugin-github/github/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type organizationOutsideCollaborator struct {
+	*github.User
+	HasTwoFactorEnabled *bool
+	Repositories        []outsideCollaboratorRepository
+}
+
+type outsideCollaboratorRepository struct {
+	Repository string `json:"repository"`
+	Permission string `json:"permission"`
+}
+
+type outsideCollaboratorEdge struct {
+	Permission githubv4.RepositoryPermission
+	Node       struct {
+		Login string
+	}
+}
+
+func tableGitHubOrganizationOutsideCollaborator() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_organization_outside_collaborator",
+		Description: "Outside collaborators of the given organization, i.e. users with access to one or more of its repositories who are not members.",
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "organization", Require: plugin.Required},
+				{Name: "has_two_factor_enabled", Require: plugin.Optional, Operators: []string{"="}},
+			},
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubOrganizationOutsideCollaboratorList,
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "organization", Type: proto.ColumnType_STRING, Transform: transform.FromQual("organization"), Description: "The organization the outside collaborator has access to."},
+			{Name: "login", Type: proto.ColumnType_STRING, Description: "The login name of the user."},
+			{Name: "id", Type: proto.ColumnType_INT, Transform: transform.FromField("ID"), Description: "The ID of the user."},
+			{Name: "has_two_factor_enabled", Type: proto.ColumnType_BOOL, Description: "Whether the user has two factor authentication enabled. Returns null if information is not available to viewer."},
+			{Name: "repositories", Type: proto.ColumnType_JSON, Description: "The repositories of the organization the user can access, with the permission granted on each."},
+			// Other columns
+			{Name: "node_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("NodeID"), Description: "The node ID of the user."},
+			{Name: "type", Type: proto.ColumnType_STRING, Description: "The type of the account, e.g. User."},
+			{Name: "site_admin", Type: proto.ColumnType_BOOL, Description: "If true, user is a site administrator."},
+			{Name: "avatar_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("AvatarURL"), Description: "The URL of the user's avatar."},
+			{Name: "html_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("HTMLURL"), Description: "The URL of the user's GitHub page."},
+		},
+	}
+}
+
+func tableGitHubOrganizationOutsideCollaboratorList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+
+	quals := d.EqualsQuals
+	org := quals["organization"].GetStringValue()
+
+	// The API can only filter on two factor authentication being disabled, if required the status of every other
+	// collaborator is inferred by listing the disabled collaborators separately
+	var disabled map[string]bool
+	onlyDisabled := quals["has_two_factor_enabled"] != nil && !quals["has_two_factor_enabled"].GetBoolValue()
+	if !onlyDisabled && slices.Contains(d.QueryContext.Columns, "has_two_factor_enabled") {
+		disabled = map[string]bool{}
+		err := listOrganizationOutsideCollaborators(ctx, client, org, "2fa_disabled", nil, func(u *github.User) bool {
+			disabled[u.GetLogin()] = true
+			return true
+		})
+		if err != nil {
+			plugin.Logger(ctx).Error("github_organization_outside_collaborator", "api_error", err)
+			return nil, err
+		}
+	}
+
+	var repositories map[string][]outsideCollaboratorRepository
+	if slices.Contains(d.QueryContext.Columns, "repositories") {
+		var err error
+		repositories, err = listOrganizationOutsideCollaboratorRepositories(ctx, d, org)
+		if err != nil {
+			plugin.Logger(ctx).Error("github_organization_outside_collaborator", "api_error", err)
+			return nil, err
+		}
+	}
+
+	filter := "all"
+	limit := d.QueryContext.Limit
+	if onlyDisabled {
+		filter = "2fa_disabled"
+	} else if quals["has_two_factor_enabled"] != nil {
+		// Collaborators are filtered after listing, so the limit cannot be applied to the request
+		limit = nil
+	}
+
+	err := listOrganizationOutsideCollaborators(ctx, client, org, filter, limit, func(u *github.User) bool {
+		row := organizationOutsideCollaborator{User: u, Repositories: repositories[u.GetLogin()]}
+		if onlyDisabled {
+			row.HasTwoFactorEnabled = github.Bool(false)
+		} else if disabled != nil {
+			row.HasTwoFactorEnabled = github.Bool(!disabled[u.GetLogin()])
+		}
+
+		// Only filter on an enabled status once it has been determined
+		if quals["has_two_factor_enabled"] != nil && row.HasTwoFactorEnabled != nil && *row.HasTwoFactorEnabled != quals["has_two_factor_enabled"].GetBoolValue() {
+			return true
+		}
+
+		d.StreamListItem(ctx, row)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		return d.RowsRemaining(ctx) != 0
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("github_organization_outside_collaborator", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// listOrganizationOutsideCollaborators calls fn with each outside collaborator of the organization, page by page, until
+// fn returns false
+func listOrganizationOutsideCollaborators(ctx context.Context, client *github.Client, org string, filter string, limit *int64, fn func(*github.User) bool) error {
+	opts := &github.ListOutsideCollaboratorsOptions{
+		Filter:      filter,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	if limit != nil {
+		if *limit < int64(opts.ListOptions.PerPage) {
+			opts.ListOptions.PerPage = int(*limit)
+		}
+	}
+
+	for {
+		page, resp, err := client.Organizations.ListOutsideCollaborators(ctx, org, opts)
+		if err != nil {
+			return err
+		}
+
+		for _, u := range page {
+			if !fn(u) {
+				return nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			return nil
+		}
+
+		opts.ListOptions.Page = resp.NextPage
+	}
+}
+
+// listOrganizationOutsideCollaboratorRepositories returns the repositories of the organization each outside
+// collaborator can access, keyed by the login of the collaborator.
+func listOrganizationOutsideCollaboratorRepositories(ctx context.Context, d *plugin.QueryData, org string) (map[string][]outsideCollaboratorRepository, error) {
+	var query struct {
+		RateLimit    models.RateLimit
+		Organization struct {
+			Repositories struct {
+				PageInfo models.PageInfo
+				Nodes    []struct {
+					Name          string
+					NameWithOwner string
+					Collaborators struct {
+						PageInfo models.PageInfo
+						Edges    []outsideCollaboratorEdge
+					} `graphql:"collaborators(first: $pageSize, affiliation: OUTSIDE)"`
+				}
+			} `graphql:"repositories(first: $pageSize, after: $cursor)"`
+		} `graphql:"organization(login: $login)"`
+	}
+
+	variables := map[string]interface{}{
+		"login":    githubv4.String(org),
+		"pageSize": githubv4.Int(50),
+		"cursor":   (*githubv4.String)(nil),
+	}
+
+	repositories := map[string][]outsideCollaboratorRepository{}
+	client := connectV4(ctx, d)
+	for {
+		err := client.Query(ctx, &query, variables)
+		plugin.Logger(ctx).Debug(rateLimitLogString("github_organization_outside_collaborator", &query.RateLimit))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, repo := range query.Organization.Repositories.Nodes {
+			edges := repo.Collaborators.Edges
+			// Repositories with many outside collaborators are paged separately
+			if repo.Collaborators.PageInfo.HasNextPage {
+				edges, err = listRepositoryOutsideCollaboratorEdges(ctx, d, org, repo.Name)
+				if err != nil {
+					return nil, err
+				}
+			}
+			for _, e := range edges {
+				repositories[e.Node.Login] = append(repositories[e.Node.Login], outsideCollaboratorRepository{
+					Repository: repo.NameWithOwner,
+					Permission: string(e.Permission),
+				})
+			}
+		}
+
+		if !query.Organization.Repositories.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Organization.Repositories.PageInfo.EndCursor)
+	}
+
+	return repositories, nil
+}
+
+func listRepositoryOutsideCollaboratorEdges(ctx context.Context, d *plugin.QueryData, owner string, repoName string) ([]outsideCollaboratorEdge, error) {
+	var query struct {
+		RateLimit  models.RateLimit
+		Repository struct {
+			Collaborators struct {
+				PageInfo models.PageInfo
+				Edges    []outsideCollaboratorEdge
+			} `graphql:"collaborators(first: $pageSize, after: $cursor, affiliation: OUTSIDE)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner":    githubv4.String(owner),
+		"repo":     githubv4.String(repoName),
+		"pageSize": githubv4.Int(100),
+		"cursor":   (*githubv4.String)(nil),
+	}
+
+	var edges []outsideCollaboratorEdge
+	client := connectV4(ctx, d)
+	for {
+		err := client.Query(ctx, &query, variables)
+		plugin.Logger(ctx).Debug(rateLimitLogString("github_organization_outside_collaborator", &query.RateLimit))
+		if err != nil {
+			return nil, err
+		}
+
+		edges = append(edges, query.Repository.Collaborators.Edges...)
+
+		if !query.Repository.Collaborators.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Repository.Collaborators.PageInfo.EndCursor)
+	}
+
+	return edges, nil
+}
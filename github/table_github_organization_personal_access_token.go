@@ -0,0 +1,164 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v55/github"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// The go-github client does not support listing fine-grained personal access tokens yet, so they are listed with raw
+// requests. The fields are shared by the token grants and the requests for access.
+type organizationPersonalAccessToken struct {
+	ID                  int64                  `json:"id"`
+	Reason              string                 `json:"reason"`
+	Owner               *github.User           `json:"owner"`
+	RepositorySelection string                 `json:"repository_selection"`
+	Permissions         map[string]interface{} `json:"permissions"`
+	AccessGrantedAt     *github.Timestamp      `json:"access_granted_at"`
+	CreatedAt           *github.Timestamp      `json:"created_at"`
+	TokenID             int64                  `json:"token_id"`
+	TokenName           string                 `json:"token_name"`
+	TokenExpired        bool                   `json:"token_expired"`
+	TokenExpiresAt      *github.Timestamp      `json:"token_expires_at"`
+	TokenLastUsedAt     *github.Timestamp      `json:"token_last_used_at"`
+}
+
+func tableGitHubOrganizationPersonalAccessToken() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_organization_personal_access_token",
+		Description: "Fine-grained personal access tokens granted access to the resources of the given organization.",
+		List: &plugin.ListConfig{
+			KeyColumns:        plugin.SingleColumn("organization"),
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubOrganizationPersonalAccessTokenList,
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "organization", Type: proto.ColumnType_STRING, Transform: transform.FromQual("organization"), Description: "The organization the token has been granted access to."},
+			{Name: "id", Type: proto.ColumnType_INT, Transform: transform.FromField("ID"), Description: "Unique ID of the grant."},
+			{Name: "owner_login", Type: proto.ColumnType_STRING, Transform: transform.FromField("Owner.Login"), Description: "The login of the user who owns the token."},
+			{Name: "token_name", Type: proto.ColumnType_STRING, Description: "The name given to the token by its owner."},
+			{Name: "repository_selection", Type: proto.ColumnType_STRING, Description: "Which repositories the token can access, one of none, all or subset."},
+			{Name: "permissions", Type: proto.ColumnType_JSON, Description: "The organization, repository and other permissions granted to the token."},
+			{Name: "token_expires_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("TokenExpiresAt").Transform(convertTimestamp), Description: "Time when the token expires. Returns null if the token does not expire."},
+			// Other columns
+			{Name: "token_id", Type: proto.ColumnType_INT, Transform: transform.FromField("TokenID"), Description: "The ID of the token."},
+			{Name: "token_expired", Type: proto.ColumnType_BOOL, Description: "If true, the token has expired."},
+			{Name: "token_last_used_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("TokenLastUsedAt").Transform(convertTimestamp), Description: "Time when the token was last used to access the organization."},
+			{Name: "access_granted_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("AccessGrantedAt").Transform(convertTimestamp), Description: "Time when the token was granted access to the organization."},
+			{Name: "repositories", Type: proto.ColumnType_JSON, Hydrate: tableGitHubOrganizationPersonalAccessTokenRepositories, Transform: transform.FromValue(), Description: "The full names of the repositories the token can access, when repository_selection is subset."},
+			{Name: "owner", Type: proto.ColumnType_JSON, Transform: transform.FromField("Owner").NullIfZero(), Description: "The user who owns the token."},
+		},
+	}
+}
+
+func tableGitHubOrganizationPersonalAccessTokenList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	org := d.EqualsQuals["organization"].GetStringValue()
+
+	err := listOrganizationPersonalAccessTokens(ctx, d, fmt.Sprintf("orgs/%v/personal-access-tokens", org))
+	if err != nil {
+		plugin.Logger(ctx).Error("github_organization_personal_access_token", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func tableGitHubOrganizationPersonalAccessTokenRepositories(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	token := h.Item.(*organizationPersonalAccessToken)
+	org := d.EqualsQuals["organization"].GetStringValue()
+
+	repositories, err := listOrganizationPersonalAccessTokenRepositories(ctx, d, token, fmt.Sprintf("orgs/%v/personal-access-tokens/%v/repositories", org, token.ID))
+	if err != nil {
+		plugin.Logger(ctx).Error("github_organization_personal_access_token.tableGitHubOrganizationPersonalAccessTokenRepositories", "api_error", err)
+		return nil, err
+	}
+
+	return repositories, nil
+}
+
+// listOrganizationPersonalAccessTokens streams every page of the tokens or token requests listed at path
+func listOrganizationPersonalAccessTokens(ctx context.Context, d *plugin.QueryData, path string) error {
+	client := connect(ctx, d)
+
+	perPage := 100
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit < int64(perPage) {
+			perPage = int(*limit)
+		}
+	}
+	page := 1
+
+	for {
+		req, err := client.NewRequest("GET", fmt.Sprintf("%v?per_page=%v&page=%v", path, perPage, page), nil)
+		if err != nil {
+			return err
+		}
+
+		var tokens []*organizationPersonalAccessToken
+		resp, err := client.Do(ctx, req, &tokens)
+		if err != nil {
+			return err
+		}
+
+		for _, t := range tokens {
+			d.StreamListItem(ctx, t)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		page = resp.NextPage
+	}
+
+	return nil
+}
+
+// listOrganizationPersonalAccessTokenRepositories lists the full names of the repositories listed at path, which are
+// only listed for tokens restricted to a subset of the repositories
+func listOrganizationPersonalAccessTokenRepositories(ctx context.Context, d *plugin.QueryData, token *organizationPersonalAccessToken, path string) ([]string, error) {
+	if token.RepositorySelection != "subset" {
+		return nil, nil
+	}
+
+	client := connect(ctx, d)
+	page := 1
+
+	repositories := []string{}
+	for {
+		req, err := client.NewRequest("GET", fmt.Sprintf("%v?per_page=100&page=%v", path, page), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var result []*github.Repository
+		resp, err := client.Do(ctx, req, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range result {
+			repositories = append(repositories, r.GetFullName())
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		page = resp.NextPage
+	}
+
+	return repositories, nil
+}
@@ -0,0 +1,65 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubOrganizationPersonalAccessTokenRequest() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_organization_personal_access_token_request",
+		Description: "Pending requests from fine-grained personal access tokens to access the resources of the given organization.",
+		List: &plugin.ListConfig{
+			KeyColumns:        plugin.SingleColumn("organization"),
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubOrganizationPersonalAccessTokenRequestList,
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "organization", Type: proto.ColumnType_STRING, Transform: transform.FromQual("organization"), Description: "The organization the token is requesting access to."},
+			{Name: "id", Type: proto.ColumnType_INT, Transform: transform.FromField("ID"), Description: "Unique ID of the request."},
+			{Name: "owner_login", Type: proto.ColumnType_STRING, Transform: transform.FromField("Owner.Login"), Description: "The login of the user who owns the token."},
+			{Name: "token_name", Type: proto.ColumnType_STRING, Description: "The name given to the token by its owner."},
+			{Name: "reason", Type: proto.ColumnType_STRING, Transform: transform.FromField("Reason").NullIfZero(), Description: "The reason given by the owner for requesting access."},
+			{Name: "repository_selection", Type: proto.ColumnType_STRING, Description: "Which repositories the token is requesting access to, one of none, all or subset."},
+			{Name: "permissions", Type: proto.ColumnType_JSON, Description: "The organization, repository and other permissions requested for the token."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("CreatedAt").Transform(convertTimestamp), Description: "Time when the request was created."},
+			// Other columns
+			{Name: "token_id", Type: proto.ColumnType_INT, Transform: transform.FromField("TokenID"), Description: "The ID of the token."},
+			{Name: "token_expired", Type: proto.ColumnType_BOOL, Description: "If true, the token has expired."},
+			{Name: "token_expires_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("TokenExpiresAt").Transform(convertTimestamp), Description: "Time when the token expires. Returns null if the token does not expire."},
+			{Name: "token_last_used_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("TokenLastUsedAt").Transform(convertTimestamp), Description: "Time when the token was last used."},
+			{Name: "repositories", Type: proto.ColumnType_JSON, Hydrate: tableGitHubOrganizationPersonalAccessTokenRequestRepositories, Transform: transform.FromValue(), Description: "The full names of the repositories the token is requesting access to, when repository_selection is subset."},
+			{Name: "owner", Type: proto.ColumnType_JSON, Transform: transform.FromField("Owner").NullIfZero(), Description: "The user who owns the token."},
+		},
+	}
+}
+
+func tableGitHubOrganizationPersonalAccessTokenRequestList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	org := d.EqualsQuals["organization"].GetStringValue()
+
+	err := listOrganizationPersonalAccessTokens(ctx, d, fmt.Sprintf("orgs/%v/personal-access-token-requests", org))
+	if err != nil {
+		plugin.Logger(ctx).Error("github_organization_personal_access_token_request", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func tableGitHubOrganizationPersonalAccessTokenRequestRepositories(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	token := h.Item.(*organizationPersonalAccessToken)
+	org := d.EqualsQuals["organization"].GetStringValue()
+
+	repositories, err := listOrganizationPersonalAccessTokenRepositories(ctx, d, token, fmt.Sprintf("orgs/%v/personal-access-token-requests/%v/repositories", org, token.ID))
+	if err != nil {
+		plugin.Logger(ctx).Error("github_organization_personal_access_token_request.tableGitHubOrganizationPersonalAccessTokenRequestRepositories", "api_error", err)
+		return nil, err
+	}
+
+	return repositories, nil
+}
@@ -0,0 +1,59 @@
+package github
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubOrganizationSecurityManager() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_organization_security_manager",
+		Description: "Teams granted the security manager role in the given organization.",
+		List: &plugin.ListConfig{
+			KeyColumns:        plugin.SingleColumn("organization"),
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubOrganizationSecurityManagerList,
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "organization", Type: proto.ColumnType_STRING, Transform: transform.FromQual("organization"), Description: "The organization the team is a security manager of."},
+			{Name: "slug", Type: proto.ColumnType_STRING, Description: "The team slug name."},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the team."},
+			{Name: "id", Type: proto.ColumnType_INT, Transform: transform.FromField("ID"), Description: "The ID of the team."},
+			// Other columns
+			{Name: "node_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("NodeID"), Description: "The node ID of the team."},
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "The description of the team."},
+			{Name: "privacy", Type: proto.ColumnType_STRING, Description: "The privacy setting of the team, either closed or secret."},
+			{Name: "parent_slug", Type: proto.ColumnType_STRING, Transform: transform.FromField("Parent.Slug"), Description: "The slug of the team's parent team, if any."},
+			{Name: "html_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("HTMLURL"), Description: "URL for the team page in GitHub."},
+		},
+	}
+}
+
+func tableGitHubOrganizationSecurityManagerList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+
+	org := d.EqualsQuals["organization"].GetStringValue()
+
+	teams, _, err := client.Organizations.ListSecurityManagerTeams(ctx, org)
+	if err != nil {
+		plugin.Logger(ctx).Error("github_organization_security_manager", "api_error", err)
+		return nil, err
+	}
+
+	for _, t := range teams {
+		if t != nil {
+			d.StreamListItem(ctx, t)
+		}
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -3,6 +3,8 @@ package github
 import (
 	"context"
 	"fmt"
+	"maps"
+	"strings"
 
 	"github.com/shurcooL/githubv4"
 	"github.com/turbot/steampipe-plugin-github/github/models"
@@ -86,6 +88,15 @@ func gitHubPullRequestColumns() []*plugin.Column {
 		{Name: "commits_total_count", Type: proto.ColumnType_INT, Hydrate: prHydrateCommitCount, Transform: transform.FromValue(), Description: "A count of commits in the pull request."},
 		{Name: "review_requests_total_count", Type: proto.ColumnType_INT, Hydrate: prHydrateReviewRequestCount, Transform: transform.FromValue(), Description: "A count of reviews requested on the pull request."},
 		{Name: "reviews_total_count", Type: proto.ColumnType_INT, Hydrate: prHydrateReviewCount, Transform: transform.FromValue(), Description: "A count of completed reviews on the pull request."},
+		{Name: "merge_state_status", Type: proto.ColumnType_STRING, Hydrate: prHydrateMergeStateStatus, Transform: transform.FromValue().NullIfZero(), Description: "Detailed status of whether the pull request can be merged, e.g. CLEAN, BLOCKED, BEHIND, DIRTY, HAS_HOOKS, UNSTABLE, DRAFT or UNKNOWN."},
+		{Name: "merge_queue_entry", Type: proto.ColumnType_JSON, Hydrate: prHydrateMergeQueueEntry, Transform: transform.FromValue().NullIfZero(), Description: "The merge queue entry of the pull request, null if it is not in a merge queue."},
+		{Name: "merge_queue_state", Type: proto.ColumnType_STRING, Hydrate: prHydrateMergeQueueState, Transform: transform.FromValue(), Description: "The state of the pull request in the merge queue, e.g. QUEUED, AWAITING_CHECKS, MERGEABLE, UNMERGEABLE or LOCKED."},
+		{Name: "merge_queue_position", Type: proto.ColumnType_INT, Hydrate: prHydrateMergeQueuePosition, Transform: transform.FromValue(), Description: "The position of the pull request in the merge queue."},
+		{Name: "auto_merge", Type: proto.ColumnType_JSON, Hydrate: prHydrateAutoMerge, Transform: transform.FromValue().NullIfZero(), Description: "The auto-merge configuration of the pull request, null if auto-merge is not enabled."},
+		{Name: "auto_merge_method", Type: proto.ColumnType_STRING, Hydrate: prHydrateAutoMergeMethod, Transform: transform.FromValue(), Description: "The merge method auto-merge will use, one of MERGE, SQUASH or REBASE."},
+		{Name: "required_approving_review_count", Type: proto.ColumnType_INT, Hydrate: prHydrateRequiredApprovingReviewCount, Transform: transform.FromValue(), Description: "Number of approving reviews required by the branch protection rule of the base branch, null if the base branch is not protected by a rule."},
+		{Name: "approving_reviews_remaining", Type: proto.ColumnType_INT, Hydrate: prHydrateApprovingReviewsRemaining, Transform: transform.FromValue(), Description: "Number of approving reviews still required by the branch protection rule of the base branch, counting the latest approval of each reviewer with write access."},
+		{Name: "raw_json", Type: proto.ColumnType_JSON, Hydrate: prHydrateRawJSON, Transform: transform.FromValue(), Description: "The pull request as returned by the REST API, including the fields which have no column. Fetching it requires an additional API request per pull request."},
 	}
 
 	return append(sharedPullRequestColumns(), tableCols...)
@@ -97,8 +108,12 @@ func tableGitHubPullRequest() *plugin.Table {
 		Description: "GitHub Pull requests let you tell others about changes you've pushed to a branch in a repository on GitHub. Once a pull request is opened, you can discuss and review the potential changes with collaborators and add follow-up commits before your changes are merged into the base branch.",
 		List: &plugin.ListConfig{
 			KeyColumns: []*plugin.KeyColumn{
-				{Name: "repository_full_name", Require: plugin.Required},
+				{Name: "repository_full_name", Require: plugin.Optional, Operators: []string{"=", "~~"}},
+				{Name: "node_id", Require: plugin.Optional},
 				{Name: "state", Require: plugin.Optional},
+				{Name: "base_ref_name", Require: plugin.Optional},
+				{Name: "head_ref_name", Require: plugin.Optional},
+				{Name: "updated_at", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<="}},
 			},
 			ShouldIgnoreError: isNotFoundError([]string{"404"}),
 			Hydrate:           tableGitHubPullRequestList,
@@ -114,8 +129,12 @@ func tableGitHubPullRequest() *plugin.Table {
 
 func tableGitHubPullRequestList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	quals := d.EqualsQuals
-	fullName := quals["repository_full_name"].GetStringValue()
-	owner, repo := parseRepoFullName(fullName)
+
+	// The pull request is looked up directly when the node ID is known, e.g.
+	// from a webhook payload or the audit log
+	if quals["node_id"] != nil {
+		return tableGitHubPullRequestNodeList(ctx, d, quals["node_id"].GetStringValue())
+	}
 
 	pageSize := adjustPageSize(75, d.QueryContext.Limit)
 
@@ -135,52 +154,96 @@ func tableGitHubPullRequestList(ctx context.Context, d *plugin.QueryData, h *plu
 		}
 	}
 
-	var query struct {
-		RateLimit  models.RateLimit
-		Repository struct {
-			PullRequests struct {
-				PageInfo   models.PageInfo
-				TotalCount int
-				Nodes      []models.PullRequest
-			} `graphql:"pullRequests(first: $pageSize, after: $cursor, states: $states)"`
-		} `graphql:"repository(owner: $owner, name: $name)"`
+	fullNames, err := repositoryFullNamesFromQuals(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("github_pull_request", "invalid filter", "repository_full_name", err)
+		return nil, err
 	}
 
-	variables := map[string]interface{}{
-		"owner":    githubv4.String(owner),
-		"name":     githubv4.String(repo),
-		"pageSize": githubv4.Int(pageSize),
-		"cursor":   (*githubv4.String)(nil),
-		"states":   states,
+	baseRefName := (*githubv4.String)(nil)
+	if quals["base_ref_name"] != nil {
+		baseRefName = githubv4.NewString(githubv4.String(quals["base_ref_name"].GetStringValue()))
+	}
+	headRefName := (*githubv4.String)(nil)
+	if quals["head_ref_name"] != nil {
+		headRefName = githubv4.NewString(githubv4.String(quals["head_ref_name"].GetStringValue()))
 	}
-	appendPullRequestColumnIncludes(&variables, d.QueryContext.Columns)
+
+	// The pullRequests connection cannot filter on the update time, so when
+	// it is filtered on the pull requests are listed most recently updated
+	// first and the listing stops once they are older than the range
+	updatedAtQuals := d.Quals["updated_at"]
+	orderBy := githubv4.IssueOrder{Field: githubv4.IssueOrderFieldCreatedAt, Direction: githubv4.OrderDirectionAsc}
+	if updatedAtQuals != nil {
+		orderBy = githubv4.IssueOrder{Field: githubv4.IssueOrderFieldUpdatedAt, Direction: githubv4.OrderDirectionDesc}
+	}
+
+	baseVariables := map[string]interface{}{
+		"pageSize":    githubv4.Int(pageSize),
+		"states":      states,
+		"baseRefName": baseRefName,
+		"headRefName": headRefName,
+		"orderBy":     orderBy,
+	}
+	appendPullRequestColumnIncludes(&baseVariables, d.QueryContext.Columns)
 
 	client := connectV4(ctx, d)
 
-	for {
-		err := client.Query(ctx, &query, variables)
-		plugin.Logger(ctx).Debug(rateLimitLogString("github_pull_request", &query.RateLimit))
-		if err != nil {
-			plugin.Logger(ctx).Error("github_pull_request", "api_error", err)
-			return nil, err
+	listRepositoryPullRequests := func(ctx context.Context, fullName string) error {
+		var query struct {
+			RateLimit  models.RateLimit
+			Repository struct {
+				PullRequests struct {
+					PageInfo   models.PageInfo
+					TotalCount int
+					Nodes      []models.PullRequest
+				} `graphql:"pullRequests(first: $pageSize, after: $cursor, states: $states, baseRefName: $baseRefName, headRefName: $headRefName, orderBy: $orderBy)"`
+			} `graphql:"repository(owner: $owner, name: $name)"`
 		}
 
-		for _, issue := range query.Repository.PullRequests.Nodes {
-			d.StreamListItem(ctx, issue)
+		owner, repo := parseRepoFullName(fullName)
+		variables := maps.Clone(baseVariables)
+		variables["owner"] = githubv4.String(owner)
+		variables["name"] = githubv4.String(repo)
+		variables["cursor"] = (*githubv4.String)(nil)
 
-			// Context can be cancelled due to manual cancellation or the limit has been hit
-			if d.RowsRemaining(ctx) == 0 {
-				return nil, nil
+		for {
+			err := client.Query(ctx, &query, variables)
+			plugin.Logger(ctx).Debug(rateLimitLogString("github_pull_request", &query.RateLimit))
+			if err != nil {
+				plugin.Logger(ctx).Error("github_pull_request", "api_error", err)
+				return err
 			}
-		}
 
-		if !query.Repository.PullRequests.PageInfo.HasNextPage {
-			break
+			passedRange := false
+			for _, issue := range query.Repository.PullRequests.Nodes {
+				if updatedAtQuals != nil {
+					position := timeRangePosition(issue.UpdatedAt.Time, updatedAtQuals)
+					if position < 0 {
+						passedRange = true
+						break
+					}
+					if position > 0 {
+						continue
+					}
+				}
+
+				d.StreamListItem(ctx, issue)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil
+				}
+			}
+
+			if passedRange || !query.Repository.PullRequests.PageInfo.HasNextPage {
+				return nil
+			}
+			variables["cursor"] = githubv4.NewString(query.Repository.PullRequests.PageInfo.EndCursor)
 		}
-		variables["cursor"] = githubv4.NewString(query.Repository.PullRequests.PageInfo.EndCursor)
 	}
 
-	return nil, nil
+	return nil, forEachRepository(ctx, d, fullNames, listRepositoryPullRequests)
 }
 
 func tableGitHubPullRequestGet(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
@@ -214,3 +277,37 @@ func tableGitHubPullRequestGet(ctx context.Context, d *plugin.QueryData, h *plug
 
 	return query.Repository.PullRequest, nil
 }
+
+func tableGitHubPullRequestNodeList(ctx context.Context, d *plugin.QueryData, nodeId string) (interface{}, error) {
+	client := connectV4(ctx, d)
+
+	var query struct {
+		RateLimit models.RateLimit
+		Node      struct {
+			PullRequest models.PullRequest `graphql:"... on PullRequest"`
+		} `graphql:"node(id: $nodeId)"`
+	}
+
+	variables := map[string]interface{}{
+		"nodeId": githubv4.ID(nodeId),
+	}
+	appendPullRequestColumnIncludes(&variables, d.QueryContext.Columns)
+
+	err := client.Query(ctx, &query, variables)
+	plugin.Logger(ctx).Debug(rateLimitLogString("github_pull_request", &query.RateLimit))
+	if err != nil {
+		plugin.Logger(ctx).Error("github_pull_request", "api_error", err)
+		if strings.Contains(err.Error(), "Could not resolve to a node with the global id of") {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// The node is not a pull request, or not one of the repository filtered on
+	if query.Node.PullRequest.NodeId == "" || !matchesRepositoryFullNameQuals(d, query.Node.PullRequest.Repo.NameWithOwner) {
+		return nil, nil
+	}
+
+	d.StreamListItem(ctx, query.Node.PullRequest)
+	return nil, nil
+}
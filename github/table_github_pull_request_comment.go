@@ -16,6 +16,11 @@ func tableGitHubPullRequestComment() *plugin.Table {
 			ShouldIgnoreError: isNotFoundError([]string{"404"}),
 			Hydrate:           tableGitHubRepositoryPullRequestCommentList,
 		},
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("node_id"),
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubPullRequestCommentGet,
+		},
 		Columns: sharedCommentsColumns(),
 	}
 }
@@ -76,3 +81,11 @@ func tableGitHubRepositoryPullRequestCommentList(ctx context.Context, d *plugin.
 
 	return nil, nil
 }
+
+func tableGitHubPullRequestCommentGet(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	comment, onPullRequest, err := getIssueCommentByNodeId(ctx, d, "github_pull_request_comment", d.EqualsQuals["node_id"].GetStringValue())
+	if err != nil || comment == nil || !onPullRequest {
+		return nil, err
+	}
+	return *comment, nil
+}
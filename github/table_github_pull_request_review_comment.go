@@ -0,0 +1,281 @@
+package github
+
+import (
+	"context"
+	"strings"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/turbot/steampipe-plugin-github/github/models"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// pullRequestReviewCommentRow is a review comment along with the status of the thread it belongs to
+type pullRequestReviewCommentRow struct {
+	RepositoryFullName string
+	Number             int
+	Comment            models.PullRequestReviewComment
+	Thread             models.PullRequestReviewThread
+}
+
+func tableGitHubPullRequestReviewComment() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_pull_request_review_comment",
+		Description: "Review comments are the comments left on the diff of a Pull Request, grouped in review threads.",
+		List: &plugin.ListConfig{
+			KeyColumns:        plugin.AllColumns([]string{"repository_full_name", "number"}),
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubPullRequestReviewCommentList,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:        plugin.SingleColumn("node_id"),
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubPullRequestReviewCommentGet,
+		},
+		Columns: []*plugin.Column{
+			{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("RepositoryFullName"), Description: "The full name of the repository (login/repo-name)."},
+			{Name: "number", Type: proto.ColumnType_INT, Transform: transform.FromField("Number"), Description: "The PR number."},
+			{Name: "id", Type: proto.ColumnType_INT, Transform: transform.FromField("Comment.Id"), Description: "The ID of the review comment."},
+			{Name: "node_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Comment.NodeId"), Description: "The node ID of the review comment."},
+			{Name: "author", Type: proto.ColumnType_JSON, Transform: transform.FromField("Comment.Author").NullIfZero(), Description: "The actor who authored the review comment."},
+			{Name: "author_login", Type: proto.ColumnType_STRING, Transform: transform.FromField("Comment.Author.Login"), Description: "The login of the review comment author."},
+			{Name: "author_association", Type: proto.ColumnType_STRING, Transform: transform.FromField("Comment.AuthorAssociation"), Description: "Author's association with the subject of the pr the review comment was raised on."},
+			{Name: "body", Type: proto.ColumnType_STRING, Transform: transform.FromField("Comment.Body"), Description: "The contents of the review comment as markdown."},
+			{Name: "body_text", Type: proto.ColumnType_STRING, Transform: transform.FromField("Comment.BodyText"), Description: "The contents of the review comment as text."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Comment.CreatedAt").NullIfZero().Transform(convertTimestamp), Description: "Timestamp when the review comment was created."},
+			{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Comment.UpdatedAt").NullIfZero().Transform(convertTimestamp), Description: "Timestamp when the review comment was last updated."},
+			{Name: "url", Type: proto.ColumnType_STRING, Transform: transform.FromField("Comment.Url"), Description: "URL for the review comment."},
+			{Name: "path", Type: proto.ColumnType_STRING, Transform: transform.FromField("Comment.Path"), Description: "The path of the file the review comment was left on."},
+			{Name: "diff_hunk", Type: proto.ColumnType_STRING, Transform: transform.FromField("Comment.DiffHunk"), Description: "The diff hunk the review comment was left on."},
+			{Name: "commit_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Comment.Commit.Oid").NullIfZero(), Description: "The SHA of the commit the review comment was left on."},
+			{Name: "review_id", Type: proto.ColumnType_INT, Transform: transform.FromField("Comment.PullRequestReview.Id").NullIfZero(), Description: "The ID of the review the comment belongs to."},
+			{Name: "in_reply_to_id", Type: proto.ColumnType_INT, Transform: transform.FromField("Comment.ReplyTo.Id").NullIfZero(), Description: "The ID of the review comment this comment replies to."},
+			{Name: "thread_node_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Thread.NodeId"), Description: "The node ID of the review thread the comment belongs to."},
+			{Name: "is_resolved", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Thread.IsResolved"), Description: "If true, the review thread of the comment has been resolved."},
+			{Name: "is_outdated", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Thread.IsOutdated"), Description: "If true, the review thread of the comment is on lines that have since changed."},
+			{Name: "is_collapsed", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Thread.IsCollapsed"), Description: "If true, the review thread of the comment is collapsed."},
+			{Name: "resolved_by_login", Type: proto.ColumnType_STRING, Transform: transform.FromField("Thread.ResolvedBy.Login").NullIfZero(), Description: "The login of the user who resolved the review thread of the comment."},
+			{Name: "diff_side", Type: proto.ColumnType_STRING, Transform: transform.FromField("Thread.DiffSide"), Description: "The side of the diff the review thread of the comment is on, either LEFT or RIGHT."},
+			{Name: "start_diff_side", Type: proto.ColumnType_STRING, Transform: transform.FromField("Thread.StartDiffSide").NullIfZero(), Description: "The side of the diff the first line of a multi-line review thread is on."},
+			{Name: "line", Type: proto.ColumnType_INT, Transform: transform.FromField("Thread.Line").NullIfZero(), Description: "The line of the diff the review thread of the comment is on."},
+			{Name: "start_line", Type: proto.ColumnType_INT, Transform: transform.FromField("Thread.StartLine").NullIfZero(), Description: "The first line of the diff a multi-line review thread is on."},
+			{Name: "original_line", Type: proto.ColumnType_INT, Transform: transform.FromField("Thread.OriginalLine").NullIfZero(), Description: "The line of the original diff the review thread of the comment was left on."},
+		},
+	}
+}
+
+func tableGitHubPullRequestReviewCommentList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	quals := d.EqualsQuals
+	prNumber := int(quals["number"].GetInt64Value())
+	fullName := quals["repository_full_name"].GetStringValue()
+	owner, repoName := parseRepoFullName(fullName)
+
+	pageSize := adjustPageSize(50, d.QueryContext.Limit)
+
+	var query struct {
+		RateLimit  models.RateLimit
+		Repository struct {
+			PullRequest struct {
+				ReviewThreads struct {
+					PageInfo   models.PageInfo
+					TotalCount int
+					Nodes      []models.PullRequestReviewThread
+				} `graphql:"reviewThreads(first: $pageSize, after: $cursor)"`
+			} `graphql:"pullRequest(number: $prNumber)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner":    githubv4.String(owner),
+		"name":     githubv4.String(repoName),
+		"prNumber": githubv4.Int(prNumber),
+		"pageSize": githubv4.Int(pageSize),
+		"cursor":   (*githubv4.String)(nil),
+	}
+
+	client := connectV4(ctx, d)
+
+	for {
+		err := client.Query(ctx, &query, variables)
+		plugin.Logger(ctx).Debug(rateLimitLogString("github_pull_request_review_comment", &query.RateLimit))
+		if err != nil {
+			plugin.Logger(ctx).Error("github_pull_request_review_comment", "api_error", err)
+			return nil, err
+		}
+
+		for _, thread := range query.Repository.PullRequest.ReviewThreads.Nodes {
+			comments := thread.Comments.Nodes
+
+			// Only the first page of comments is fetched with the threads
+			if thread.Comments.PageInfo.HasNextPage {
+				remaining, err := listPullRequestReviewThreadComments(ctx, client, thread.NodeId, thread.Comments.PageInfo.EndCursor)
+				if err != nil {
+					plugin.Logger(ctx).Error("github_pull_request_review_comment", "api_error", err)
+					return nil, err
+				}
+				comments = append(comments, remaining...)
+			}
+
+			for _, comment := range comments {
+				d.StreamListItem(ctx, pullRequestReviewCommentRow{RepositoryFullName: fullName, Number: prNumber, Comment: comment, Thread: thread})
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+
+		if !query.Repository.PullRequest.ReviewThreads.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Repository.PullRequest.ReviewThreads.PageInfo.EndCursor)
+	}
+
+	return nil, nil
+}
+
+func tableGitHubPullRequestReviewCommentGet(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	nodeId := d.EqualsQuals["node_id"].GetStringValue()
+
+	var query struct {
+		RateLimit models.RateLimit
+		Node      struct {
+			PullRequestReviewComment struct {
+				models.PullRequestReviewComment
+				PullRequest struct {
+					Number     int
+					Repository struct {
+						NameWithOwner string
+					}
+				}
+			} `graphql:"... on PullRequestReviewComment"`
+		} `graphql:"node(id: $nodeId)"`
+	}
+
+	variables := map[string]interface{}{
+		"nodeId": githubv4.ID(nodeId),
+	}
+
+	client := connectV4(ctx, d)
+	err := client.Query(ctx, &query, variables)
+	plugin.Logger(ctx).Debug(rateLimitLogString("github_pull_request_review_comment", &query.RateLimit))
+	if err != nil {
+		plugin.Logger(ctx).Error("github_pull_request_review_comment", "api_error", err)
+		if strings.Contains(err.Error(), "Could not resolve to a node with the global id of") {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	node := query.Node.PullRequestReviewComment
+	if node.NodeId == "" {
+		return nil, nil
+	}
+
+	// A review comment does not link to its thread, so the thread is found
+	// among the review threads of the pull request
+	fullName := node.PullRequest.Repository.NameWithOwner
+	thread, err := findPullRequestReviewThread(ctx, client, fullName, node.PullRequest.Number, node.NodeId)
+	if err != nil {
+		plugin.Logger(ctx).Error("github_pull_request_review_comment", "api_error", err)
+		return nil, err
+	}
+
+	return pullRequestReviewCommentRow{RepositoryFullName: fullName, Number: node.PullRequest.Number, Comment: node.PullRequestReviewComment, Thread: thread}, nil
+}
+
+// findPullRequestReviewThread returns the review thread of the pull request that contains the comment with the given
+// node ID, or an empty thread if there is none
+func findPullRequestReviewThread(ctx context.Context, client *githubv4.Client, fullName string, number int, commentNodeId string) (models.PullRequestReviewThread, error) {
+	owner, repoName := parseRepoFullName(fullName)
+
+	var query struct {
+		RateLimit  models.RateLimit
+		Repository struct {
+			PullRequest struct {
+				ReviewThreads struct {
+					PageInfo models.PageInfo
+					Nodes    []models.PullRequestReviewThread
+				} `graphql:"reviewThreads(first: 50, after: $cursor)"`
+			} `graphql:"pullRequest(number: $prNumber)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner":    githubv4.String(owner),
+		"name":     githubv4.String(repoName),
+		"prNumber": githubv4.Int(number),
+		"cursor":   (*githubv4.String)(nil),
+	}
+
+	for {
+		err := client.Query(ctx, &query, variables)
+		plugin.Logger(ctx).Debug(rateLimitLogString("github_pull_request_review_comment", &query.RateLimit))
+		if err != nil {
+			return models.PullRequestReviewThread{}, err
+		}
+
+		for _, thread := range query.Repository.PullRequest.ReviewThreads.Nodes {
+			comments := thread.Comments.Nodes
+			if thread.Comments.PageInfo.HasNextPage {
+				remaining, err := listPullRequestReviewThreadComments(ctx, client, thread.NodeId, thread.Comments.PageInfo.EndCursor)
+				if err != nil {
+					return models.PullRequestReviewThread{}, err
+				}
+				comments = append(comments, remaining...)
+			}
+
+			for _, comment := range comments {
+				if comment.NodeId == commentNodeId {
+					return thread, nil
+				}
+			}
+		}
+
+		if !query.Repository.PullRequest.ReviewThreads.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Repository.PullRequest.ReviewThreads.PageInfo.EndCursor)
+	}
+
+	return models.PullRequestReviewThread{}, nil
+}
+
+func listPullRequestReviewThreadComments(ctx context.Context, client *githubv4.Client, threadId string, cursor githubv4.String) ([]models.PullRequestReviewComment, error) {
+	var query struct {
+		RateLimit models.RateLimit
+		Node      struct {
+			PullRequestReviewThread struct {
+				Comments struct {
+					PageInfo models.PageInfo
+					Nodes    []models.PullRequestReviewComment
+				} `graphql:"comments(first: 100, after: $cursor)"`
+			} `graphql:"... on PullRequestReviewThread"`
+		} `graphql:"node(id: $threadId)"`
+	}
+
+	variables := map[string]interface{}{
+		"threadId": githubv4.ID(threadId),
+		"cursor":   githubv4.NewString(cursor),
+	}
+
+	var comments []models.PullRequestReviewComment
+	for {
+		err := client.Query(ctx, &query, variables)
+		plugin.Logger(ctx).Debug(rateLimitLogString("github_pull_request_review_comment", &query.RateLimit))
+		if err != nil {
+			return nil, err
+		}
+
+		comments = append(comments, query.Node.PullRequestReviewThread.Comments.Nodes...)
+
+		if !query.Node.PullRequestReviewThread.Comments.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Node.PullRequestReviewThread.Comments.PageInfo.EndCursor)
+	}
+
+	return comments, nil
+}
@@ -0,0 +1,48 @@
+package github
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubQueryCost() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_query_cost",
+		Description: "The API cost of the scans of each table of the connection since the plugin started.",
+		List: &plugin.ListConfig{
+			Hydrate: listGitHubQueryCost,
+		},
+		// The costs change with every query, so they must not be cached
+		Cache: &plugin.TableCacheOptions{
+			Enabled: false,
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "table_name", Type: proto.ColumnType_STRING, Description: "The name of the table which made the requests."},
+			{Name: "rest_requests", Type: proto.ColumnType_INT, Description: "The number of REST API requests made by scans of the table."},
+			{Name: "rest_cached_requests", Type: proto.ColumnType_INT, Description: "The number of REST API requests made by scans of the table which were served from the ETag cache, and did not count against the rate limit."},
+			{Name: "graphql_requests", Type: proto.ColumnType_INT, Transform: transform.FromField("GraphQLRequests"), Description: "The number of GraphQL API requests made by scans of the table."},
+			{Name: "graphql_points", Type: proto.ColumnType_INT, Transform: transform.FromField("GraphQLPoints"), Description: "The number of GraphQL rate limit points used by scans of the table."},
+			{Name: "disk_cached_requests", Type: proto.ColumnType_INT, Description: "The number of REST and GraphQL API requests of scans of the table which were served from the disk cache, and were not sent."},
+			// Other columns
+			{Name: "first_request_at", Type: proto.ColumnType_TIMESTAMP, Description: "The time of the first request made by a scan of the table."},
+			{Name: "last_request_at", Type: proto.ColumnType_TIMESTAMP, Description: "The time of the last request made by a scan of the table."},
+		},
+	}
+}
+
+func listGitHubQueryCost(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	for _, row := range listQueryCosts(d.Connection.Name) {
+		d.StreamListItem(ctx, row)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -2,12 +2,34 @@ package github
 
 import (
 	"context"
+	"fmt"
+
+	"github.com/google/go-github/v55/github"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 )
 
+// rateLimitResourceRate is the rate limit of a resource as returned by /rate_limit. The response has more resources than
+// github.RateLimits, and the number of requests used.
+type rateLimitResourceRate struct {
+	Limit     int              `json:"limit"`
+	Used      int              `json:"used"`
+	Remaining int              `json:"remaining"`
+	Reset     github.Timestamp `json:"reset"`
+}
+
+type rateLimitRow struct {
+	Core                *rateLimitResourceRate
+	Search              *rateLimitResourceRate
+	GraphQL             *rateLimitResourceRate
+	CodeSearch          *rateLimitResourceRate
+	CodeScanningUpload  *rateLimitResourceRate
+	IntegrationManifest *rateLimitResourceRate
+	Resources           map[string]*rateLimitResourceRate
+}
+
 func tableGitHubRateLimit() *plugin.Table {
 	return &plugin.Table{
 		Name:        "github_rate_limit",
@@ -23,6 +45,23 @@ func tableGitHubRateLimit() *plugin.Table {
 			{Name: "search_limit", Type: proto.ColumnType_INT, Transform: transform.FromField("Search.Limit"), Description: "The number of requests per hour the client is currently limited to."},
 			{Name: "search_remaining", Type: proto.ColumnType_INT, Transform: transform.FromField("Search.Remaining"), Description: "The number of remaining requests the client can make this hour."},
 			{Name: "search_reset", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Search.Reset").Transform(convertTimestamp), Description: "The time at which the current rate limit will reset."},
+			{Name: "graphql_limit", Type: proto.ColumnType_INT, Transform: transform.FromField("GraphQL.Limit"), Description: "The number of GraphQL API points per hour the client is currently limited to."},
+			{Name: "graphql_remaining", Type: proto.ColumnType_INT, Transform: transform.FromField("GraphQL.Remaining"), Description: "The number of remaining GraphQL API points the client can use this hour."},
+			{Name: "graphql_reset", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("GraphQL.Reset").Transform(convertTimestamp), Description: "The time at which the current GraphQL API rate limit will reset."},
+			// Other columns
+			{Name: "core_used", Type: proto.ColumnType_INT, Transform: transform.FromField("Core.Used"), Description: "The number of requests the client has made this hour."},
+			{Name: "search_used", Type: proto.ColumnType_INT, Transform: transform.FromField("Search.Used"), Description: "The number of search requests the client has made this minute."},
+			{Name: "graphql_used", Type: proto.ColumnType_INT, Transform: transform.FromField("GraphQL.Used"), Description: "The number of GraphQL API points the client has used this hour."},
+			{Name: "code_search_limit", Type: proto.ColumnType_INT, Transform: transform.FromField("CodeSearch.Limit"), Description: "The number of code search requests per minute the client is currently limited to."},
+			{Name: "code_search_remaining", Type: proto.ColumnType_INT, Transform: transform.FromField("CodeSearch.Remaining"), Description: "The number of remaining code search requests the client can make this minute."},
+			{Name: "code_search_reset", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("CodeSearch.Reset").Transform(convertTimestamp), Description: "The time at which the current code search rate limit will reset."},
+			{Name: "code_scanning_upload_limit", Type: proto.ColumnType_INT, Transform: transform.FromField("CodeScanningUpload.Limit"), Description: "The number of code scanning uploads per hour the client is currently limited to."},
+			{Name: "code_scanning_upload_remaining", Type: proto.ColumnType_INT, Transform: transform.FromField("CodeScanningUpload.Remaining"), Description: "The number of remaining code scanning uploads the client can make this hour."},
+			{Name: "code_scanning_upload_reset", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("CodeScanningUpload.Reset").Transform(convertTimestamp), Description: "The time at which the current code scanning upload rate limit will reset."},
+			{Name: "integration_manifest_limit", Type: proto.ColumnType_INT, Transform: transform.FromField("IntegrationManifest.Limit"), Description: "The number of GitHub App manifest conversions per hour the client is currently limited to."},
+			{Name: "integration_manifest_remaining", Type: proto.ColumnType_INT, Transform: transform.FromField("IntegrationManifest.Remaining"), Description: "The number of remaining GitHub App manifest conversions the client can make this hour."},
+			{Name: "integration_manifest_reset", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("IntegrationManifest.Reset").Transform(convertTimestamp), Description: "The time at which the current GitHub App manifest conversion rate limit will reset."},
+			{Name: "resources", Type: proto.ColumnType_JSON, Description: "The rate limit of every resource, keyed by resource name, e.g. core, search, graphql, source_import, actions_runner_registration, scim and dependency_snapshots."},
 		},
 	}
 }
@@ -30,14 +69,33 @@ func tableGitHubRateLimit() *plugin.Table {
 func listGitHubRateLimit(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	client := connect(ctx, d)
 
-	rateLimits, _, err := client.RateLimits(ctx)
+	// The response is decoded directly, as github.RateLimits only has some of
+	// the resources
+	req, err := client.NewRequest("GET", "rate_limit", nil)
 	if err != nil {
 		return nil, err
 	}
 
-	if rateLimits != nil {
-		d.StreamListItem(ctx, rateLimits)
+	var response struct {
+		Resources map[string]*rateLimitResourceRate `json:"resources"`
 	}
+	_, err = client.Do(ctx, req, &response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Resources == nil {
+		return nil, fmt.Errorf("no rate limit resources were returned")
+	}
+
+	d.StreamListItem(ctx, rateLimitRow{
+		Core:                response.Resources["core"],
+		Search:              response.Resources["search"],
+		GraphQL:             response.Resources["graphql"],
+		CodeSearch:          response.Resources["code_search"],
+		CodeScanningUpload:  response.Resources["code_scanning_upload"],
+		IntegrationManifest: response.Resources["integration_manifest"],
+		Resources:           response.Resources,
+	})
 
 	return nil, nil
 }
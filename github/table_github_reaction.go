@@ -0,0 +1,247 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-github/v55/github"
+	"github.com/shurcooL/githubv4"
+	"github.com/turbot/steampipe-plugin-github/github/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubReaction() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_reaction",
+		Description: "Reactions on an issue, pull request or comment.",
+		List: &plugin.ListConfig{
+			// The subject is identified by its node ID, or by the repository_full_name along with the number or
+			// the comment_id, so one of subject_node_id, number and comment_id is required
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "subject_node_id", Require: plugin.AnyOf},
+				{Name: "number", Require: plugin.AnyOf},
+				{Name: "comment_id", Require: plugin.AnyOf},
+				{Name: "repository_full_name", Require: plugin.Optional},
+			},
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubReactionList,
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "subject_node_id", Type: proto.ColumnType_STRING, Transform: transform.FromQual("subject_node_id"), Description: "The node ID of the issue, pull request or comment that was reacted to."},
+			{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromQual("repository_full_name"), Description: "The full name of the repository of the reacted to issue, pull request or comment."},
+			{Name: "number", Type: proto.ColumnType_INT, Transform: transform.FromQual("number"), Description: "The number of the reacted to issue or pull request."},
+			{Name: "comment_id", Type: proto.ColumnType_INT, Transform: transform.FromQual("comment_id"), Description: "The ID of the reacted to issue or pull request comment."},
+			{Name: "content", Type: proto.ColumnType_STRING, Description: "The emoji of the reaction, for example THUMBS_UP or HEART."},
+			{Name: "user_login", Type: proto.ColumnType_STRING, Transform: transform.FromField("User.Login").NullIfZero(), Description: "The login of the user that reacted."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("CreatedAt").NullIfZero().Transform(convertTimestamp), Description: "Timestamp when the reaction was created."},
+			// Other columns
+			{Name: "id", Type: proto.ColumnType_INT, Transform: transform.FromField("DatabaseId"), Description: "The ID of the reaction."},
+			{Name: "node_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("NodeId"), Description: "The node ID of the reaction."},
+			{Name: "user", Type: proto.ColumnType_JSON, Transform: transform.FromField("User").NullIfZero(), Description: "The user that reacted."},
+		},
+	}
+}
+
+func tableGitHubReactionList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	quals := d.EqualsQuals
+
+	nodeId := quals["subject_node_id"].GetStringValue()
+	if nodeId == "" {
+		id, err := getReactionSubjectNodeId(ctx, d)
+		if err != nil {
+			plugin.Logger(ctx).Error("github_reaction", "api_error", err)
+			return nil, err
+		}
+		nodeId = id
+	}
+
+	var query struct {
+		RateLimit models.RateLimit
+		Node      struct {
+			Reactable struct {
+				Reactions struct {
+					PageInfo models.PageInfo
+					Nodes    []models.Reaction
+				} `graphql:"reactions(first: $pageSize, after: $cursor)"`
+			} `graphql:"... on Reactable"`
+		} `graphql:"node(id: $nodeId)"`
+	}
+
+	pageSize := adjustPageSize(100, d.QueryContext.Limit)
+	variables := map[string]interface{}{
+		"nodeId":   githubv4.ID(nodeId),
+		"pageSize": githubv4.Int(pageSize),
+		"cursor":   (*githubv4.String)(nil),
+	}
+
+	client := connectV4(ctx, d)
+	for {
+		err := client.Query(ctx, &query, variables)
+		plugin.Logger(ctx).Debug(rateLimitLogString("github_reaction", &query.RateLimit))
+		if err != nil {
+			plugin.Logger(ctx).Error("github_reaction", "api_error", err)
+			if strings.Contains(err.Error(), "Could not resolve to a node with the global id of") {
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		for _, reaction := range query.Node.Reactable.Reactions.Nodes {
+			d.StreamListItem(ctx, reaction)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if !query.Node.Reactable.Reactions.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Node.Reactable.Reactions.PageInfo.EndCursor)
+	}
+
+	return nil, nil
+}
+
+// getReactionSubjectNodeId resolves the node ID of the issue, pull request, comment or review comment identified by the
+// repository_full_name together with either the number or the comment_id qual
+func getReactionSubjectNodeId(ctx context.Context, d *plugin.QueryData) (string, error) {
+	quals := d.EqualsQuals
+	fullName := quals["repository_full_name"].GetStringValue()
+	if fullName == "" {
+		return "", fmt.Errorf("'repository_full_name' must be provided along with 'number' or 'comment_id'")
+	}
+	owner, repo := parseRepoFullName(fullName)
+
+	client := connect(ctx, d)
+
+	// Issue comments and pull request conversation comments share the same ID space, pull request review comments
+	// have their own and are looked up if there is no such conversation comment
+	if quals["comment_id"] != nil {
+		commentId := quals["comment_id"].GetInt64Value()
+		comment, resp, err := client.Issues.GetComment(ctx, owner, repo, commentId)
+		if err == nil {
+			return comment.GetNodeID(), nil
+		}
+		if resp == nil || resp.StatusCode != http.StatusNotFound {
+			return "", err
+		}
+		reviewComment, _, err := client.PullRequests.GetComment(ctx, owner, repo, commentId)
+		if err != nil {
+			return "", err
+		}
+		return reviewComment.GetNodeID(), nil
+	}
+
+	// Pull requests are also returned by the issues API
+	if quals["number"] != nil {
+		issue, _, err := client.Issues.Get(ctx, owner, repo, int(quals["number"].GetInt64Value()))
+		if err != nil {
+			return "", err
+		}
+		return issue.GetNodeID(), nil
+	}
+
+	return "", fmt.Errorf("either 'number' or 'comment_id' must be provided along with 'repository_full_name'")
+}
+
+// listReactions returns all of the reactions on the node with the given ID
+func listReactions(ctx context.Context, d *plugin.QueryData, nodeId string) ([]models.Reaction, error) {
+	var query struct {
+		RateLimit models.RateLimit
+		Node      struct {
+			Reactable struct {
+				Reactions struct {
+					PageInfo models.PageInfo
+					Nodes    []models.Reaction
+				} `graphql:"reactions(first: $pageSize, after: $cursor)"`
+			} `graphql:"... on Reactable"`
+		} `graphql:"node(id: $nodeId)"`
+	}
+
+	variables := map[string]interface{}{
+		"nodeId":   githubv4.ID(nodeId),
+		"pageSize": githubv4.Int(100),
+		"cursor":   (*githubv4.String)(nil),
+	}
+
+	client := connectV4(ctx, d)
+
+	var reactions []models.Reaction
+	for {
+		err := client.Query(ctx, &query, variables)
+		plugin.Logger(ctx).Debug(rateLimitLogString("listReactions", &query.RateLimit))
+		if err != nil {
+			return nil, err
+		}
+
+		reactions = append(reactions, query.Node.Reactable.Reactions.Nodes...)
+
+		if !query.Node.Reactable.Reactions.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Node.Reactable.Reactions.PageInfo.EndCursor)
+	}
+
+	return reactions, nil
+}
+
+// reactionGroupsFromRest maps the reaction counts returned by the REST API to reaction groups
+func reactionGroupsFromRest(reactions *github.Reactions) []models.ReactionGroup {
+	counts := []struct {
+		content githubv4.ReactionContent
+		count   int
+	}{
+		{githubv4.ReactionContentThumbsUp, reactions.GetPlusOne()},
+		{githubv4.ReactionContentThumbsDown, reactions.GetMinusOne()},
+		{githubv4.ReactionContentLaugh, reactions.GetLaugh()},
+		{githubv4.ReactionContentHooray, reactions.GetHooray()},
+		{githubv4.ReactionContentConfused, reactions.GetConfused()},
+		{githubv4.ReactionContentHeart, reactions.GetHeart()},
+		{githubv4.ReactionContentRocket, reactions.GetRocket()},
+		{githubv4.ReactionContentEyes, reactions.GetEyes()},
+	}
+
+	var groups []models.ReactionGroup
+	for _, c := range counts {
+		group := models.ReactionGroup{Content: c.content}
+		group.Reactors.TotalCount = c.count
+		groups = append(groups, group)
+	}
+	return groups
+}
+
+func reactionGroupsTotalCount(_ context.Context, input *transform.TransformData) (interface{}, error) {
+	groups, ok := input.Value.([]models.ReactionGroup)
+	if !ok {
+		return nil, nil
+	}
+
+	total := 0
+	for _, group := range groups {
+		total += group.Reactors.TotalCount
+	}
+	return total, nil
+}
+
+func reactionGroupsToMap(_ context.Context, input *transform.TransformData) (interface{}, error) {
+	groups, ok := input.Value.([]models.ReactionGroup)
+	if !ok {
+		return nil, nil
+	}
+
+	counts := make(map[string]int)
+	for _, group := range groups {
+		if group.Reactors.TotalCount > 0 {
+			counts[string(group.Content)] = group.Reactors.TotalCount
+		}
+	}
+	return counts, nil
+}
@@ -2,6 +2,9 @@ package github
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"slices"
 
 	"github.com/google/go-github/v55/github"
 
@@ -15,7 +18,11 @@ func tableGitHubRelease() *plugin.Table {
 		Name:        "github_release",
 		Description: "GitHub Releases bundle project files for download by users.",
 		List: &plugin.ListConfig{
-			KeyColumns:        plugin.SingleColumn("repository_full_name"),
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "repository_full_name", Require: plugin.Required},
+				{Name: "tag_name", Require: plugin.Optional},
+				{Name: "is_latest", Require: plugin.Optional, Operators: []string{"="}},
+			},
 			ShouldIgnoreError: isNotFoundError([]string{"404"}),
 			Hydrate:           tableGitHubReleaseList,
 		},
@@ -30,7 +37,7 @@ func tableGitHubRelease() *plugin.Table {
 			{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromQual("repository_full_name"), Description: "Full name of the repository that contains the release."},
 
 			// Other columns
-			{Name: "assets", Type: proto.ColumnType_JSON, Description: "List of assets contained in the release."},
+			{Name: "assets", Type: proto.ColumnType_JSON, Description: "List of assets contained in the release, including the state and the SHA-256 digest of each asset."},
 			{Name: "assets_url", Type: proto.ColumnType_STRING, Description: "Assets URL for the release."},
 			{Name: "author_login", Type: proto.ColumnType_STRING, Transform: transform.FromField("Author.Login"), Description: "The login name of the user that created the release."},
 			{Name: "body", Type: proto.ColumnType_STRING, Description: "Text describing the contents of the tag."},
@@ -38,9 +45,13 @@ func tableGitHubRelease() *plugin.Table {
 			{Name: "draft", Type: proto.ColumnType_BOOL, Description: "True if this is a draft (unpublished) release."},
 			{Name: "html_url", Type: proto.ColumnType_STRING, Description: "HTML URL for the release."},
 			{Name: "id", Type: proto.ColumnType_INT, Description: "Unique ID of the release."},
+			{Name: "is_latest", Type: proto.ColumnType_BOOL, Description: "True if this is the latest release of the repository, i.e. the most recent non-prerelease, non-draft release unless another one has been marked as the latest."},
+			{Name: "mentions_count", Type: proto.ColumnType_INT, Description: "The number of users mentioned in the release notes."},
 			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the release."},
 			{Name: "node_id", Type: proto.ColumnType_STRING, Description: "Node where GitHub stores this data internally."},
 			{Name: "prerelease", Type: proto.ColumnType_BOOL, Description: "True if this is a prerelease version."},
+			{Name: "reactions", Type: proto.ColumnType_JSON, Description: "The counts of each reaction to the release."},
+			{Name: "reactions_total_count", Type: proto.ColumnType_INT, Transform: transform.FromField("Reactions.TotalCount"), Description: "The total number of reactions to the release."},
 			{Name: "published_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("PublishedAt").NullIfZero().Transform(convertTimestamp), Description: "Time when the release was published."},
 			{Name: "tag_name", Type: proto.ColumnType_STRING, Description: "The name of the tag the release is associated with."},
 			{Name: "tarball_url", Type: proto.ColumnType_STRING, Description: "Tarball URL for the release."},
@@ -52,11 +63,58 @@ func tableGitHubRelease() *plugin.Table {
 	}
 }
 
+// releaseRow is a release with the fields the go-github client does not decode
+type releaseRow struct {
+	github.RepositoryRelease
+	Assets        []*releaseAsset   `json:"assets,omitempty"`
+	Reactions     *github.Reactions `json:"reactions,omitempty"`
+	MentionsCount *int              `json:"mentions_count,omitempty"`
+	IsLatest      bool              `json:"-"`
+}
+
+// releaseAsset is a release asset with its digest, which the go-github client
+// does not decode
+type releaseAsset struct {
+	github.ReleaseAsset
+	Digest *string `json:"digest,omitempty"`
+}
+
 func tableGitHubReleaseList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	client := connect(ctx, d)
 
-	fullName := d.EqualsQuals["repository_full_name"].GetStringValue()
+	quals := d.EqualsQuals
+	fullName := quals["repository_full_name"].GetStringValue()
 	owner, repo := parseRepoFullName(fullName)
+
+	// A release is looked up directly by tag name, or as the latest release,
+	// rather than by scanning all of the releases
+	if quals["tag_name"] != nil || (quals["is_latest"] != nil && quals["is_latest"].GetBoolValue()) {
+		urlStr := fmt.Sprintf("repos/%s/%s/releases/latest", owner, repo)
+		if quals["tag_name"] != nil {
+			urlStr = fmt.Sprintf("repos/%s/%s/releases/tags/%s", owner, repo, url.PathEscape(quals["tag_name"].GetStringValue()))
+		}
+		release, err := getReleaseRow(ctx, client, urlStr)
+		if err != nil {
+			return nil, err
+		}
+		if quals["tag_name"] == nil {
+			release.IsLatest = true
+		} else {
+			latestID, err := getLatestReleaseID(ctx, d, client, owner, repo)
+			if err != nil {
+				return nil, err
+			}
+			release.IsLatest = latestID != 0 && release.GetID() == latestID
+		}
+		d.StreamListItem(ctx, release)
+		return nil, nil
+	}
+
+	latestID, err := getLatestReleaseID(ctx, d, client, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
 	opts := &github.ListOptions{PerPage: 100}
 
 	limit := d.QueryContext.Limit
@@ -67,13 +125,20 @@ func tableGitHubReleaseList(ctx context.Context, d *plugin.QueryData, h *plugin.
 	}
 
 	for {
-		releases, resp, err := client.Repositories.ListReleases(ctx, owner, repo, opts)
+		req, err := client.NewRequest("GET", fmt.Sprintf("repos/%s/%s/releases?per_page=%v&page=%v", owner, repo, opts.PerPage, opts.Page), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var releases []*releaseRow
+		resp, err := client.Do(ctx, req, &releases)
 		if err != nil {
 			return nil, err
 		}
 
 		for _, i := range releases {
 			if i != nil {
+				i.IsLatest = latestID != 0 && i.GetID() == latestID
 				d.StreamListItem(ctx, i)
 			}
 
@@ -100,10 +165,43 @@ func tableGitHubReleaseGet(ctx context.Context, d *plugin.QueryData, h *plugin.H
 	plugin.Logger(ctx).Trace("tableGitHubReleaseGet", "owner", owner, "repo", repo, "id", id)
 
 	client := connect(ctx, d)
-	release, _, err := client.Repositories.GetRelease(ctx, owner, repo, id)
+	release, err := getReleaseRow(ctx, client, fmt.Sprintf("repos/%s/%s/releases/%d", owner, repo, id))
+	if err != nil {
+		return nil, err
+	}
+
+	latestID, err := getLatestReleaseID(ctx, d, client, owner, repo)
 	if err != nil {
 		return nil, err
 	}
+	release.IsLatest = latestID != 0 && release.GetID() == latestID
 
 	return release, nil
 }
+
+func getReleaseRow(ctx context.Context, client *github.Client, urlStr string) (*releaseRow, error) {
+	req, err := client.NewRequest("GET", urlStr, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var release releaseRow
+	if _, err := client.Do(ctx, req, &release); err != nil {
+		return nil, err
+	}
+	return &release, nil
+}
+
+// getLatestReleaseID returns the ID of the latest release of the repository,
+// or 0 if it has none. It is only looked up if the is_latest column is selected.
+func getLatestReleaseID(ctx context.Context, d *plugin.QueryData, client *github.Client, owner string, repo string) (int64, error) {
+	if !slices.Contains(d.QueryContext.Columns, "is_latest") {
+		return 0, nil
+	}
+
+	release, _, err := client.Repositories.GetLatestRelease(ctx, owner, repo)
+	if err != nil && !isNotFoundError([]string{"404"})(err) {
+		return 0, err
+	}
+	return release.GetID(), nil
+}
@@ -5,8 +5,6 @@ import (
 	"strings"
 
 	"github.com/google/go-github/v55/github"
-	"github.com/shurcooL/githubv4"
-	"github.com/turbot/steampipe-plugin-github/github/models"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
@@ -100,6 +98,7 @@ func sharedRepositoryColumns() []*plugin.Column {
 		{Name: "has_downloads", Type: proto.ColumnType_BOOL, Description: "If true, the GitHub Downloads feature is enabled on the repository.", Hydrate: hydrateRepositoryDataFromV3},
 		{Name: "has_pages", Type: proto.ColumnType_BOOL, Description: "If true, the GitHub Pages feature is enabled on the repository.", Hydrate: hydrateRepositoryDataFromV3},
 		{Name: "network_count", Type: proto.ColumnType_INT, Description: "The number of member repositories in the network.", Hydrate: hydrateRepositoryDataFromV3},
+		{Name: "raw_json", Type: proto.ColumnType_JSON, Hydrate: repoHydrateRawJSON, Transform: transform.FromValue(), Description: "The repository as returned by the REST API, including the fields which have no column. It is fetched with the same API request as the other columns which come from the REST API."},
 	}
 }
 
@@ -117,30 +116,17 @@ func tableGitHubRepository() *plugin.Table {
 }
 
 func tableGitHubRepositoryList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	client := connectV4(ctx, d)
-
 	repoFullName := d.EqualsQuals["full_name"].GetStringValue()
-	owner, repoName := parseRepoFullName(repoFullName)
-
-	var query struct {
-		RateLimit  models.RateLimit
-		Repository models.Repository `graphql:"repository(owner: $owner, name: $name)"`
-	}
 
-	variables := map[string]interface{}{
-		"owner": githubv4.String(owner),
-		"name":  githubv4.String(repoName),
-	}
-	appendRepoColumnIncludes(&variables, d.QueryContext.Columns)
-
-	err := client.Query(ctx, &query, variables)
-	plugin.Logger(ctx).Debug(rateLimitLogString("github_repository", &query.RateLimit))
+	// Lookups of several repositories, e.g. from an IN list, are batched into
+	// a single query, and lookups of the same repository are shared
+	repo, err := getRepository(ctx, d, repoFullName)
 	if err != nil {
 		plugin.Logger(ctx).Error("github_repository", "api_error", err)
 		return nil, err
 	}
 
-	d.StreamListItem(ctx, query.Repository)
+	d.StreamListItem(ctx, repo)
 
 	return nil, nil
 }
@@ -153,8 +139,7 @@ func hydrateRepositoryDataFromV3(ctx context.Context, d *plugin.QueryData, h *pl
 	owner := repo.Owner.Login
 	repoName := repo.Name
 
-	client := connect(ctx, d)
-	r, _, err := client.Repositories.Get(ctx, owner, repoName)
+	r, err := getRepositoryV3(ctx, d, owner, repoName)
 	if err != nil {
 		if strings.Contains(err.Error(), "404") {
 			return nil, nil
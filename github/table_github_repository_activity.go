@@ -0,0 +1,149 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/go-github/v55/github"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubRepositoryActivity() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_repository_activity",
+		Description: "Activity on the refs of the given repository, such as pushes, force pushes and the creation and deletion of branches.",
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "repository_full_name", Require: plugin.Required},
+				{Name: "activity_type", Require: plugin.Optional},
+				{Name: "ref", Require: plugin.Optional},
+				{Name: "actor_login", Require: plugin.Optional},
+				{Name: "timestamp", Require: plugin.Optional, Operators: []string{">", ">="}},
+			},
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubRepositoryActivityList,
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromQual("repository_full_name"), Description: "Full name of the repository the activity happened in."},
+			{Name: "id", Type: proto.ColumnType_INT, Transform: transform.FromField("ID"), Description: "Unique ID of the activity."},
+			{Name: "activity_type", Type: proto.ColumnType_STRING, Description: "The type of the activity, one of push, force_push, branch_creation, branch_deletion, pr_merge or merge_queue_merge."},
+			{Name: "ref", Type: proto.ColumnType_STRING, Description: "The full ref the activity happened on, e.g. refs/heads/main."},
+			{Name: "timestamp", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Timestamp").Transform(convertTimestamp), Description: "Time when the activity happened."},
+			{Name: "actor_login", Type: proto.ColumnType_STRING, Transform: transform.FromField("Actor.Login"), Description: "The login of the user who performed the activity."},
+
+			// Other columns
+			{Name: "actor", Type: proto.ColumnType_JSON, Description: "The user who performed the activity."},
+			{Name: "before_sha", Type: proto.ColumnType_STRING, Transform: transform.FromField("Before"), Description: "The SHA the ref pointed to before the activity, all zeroes for a created branch."},
+			{Name: "after_sha", Type: proto.ColumnType_STRING, Transform: transform.FromField("After"), Description: "The SHA the ref points to after the activity, all zeroes for a deleted branch."},
+			{Name: "node_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("NodeID"), Description: "Node where GitHub stores this data internally."},
+		},
+	}
+}
+
+// repositoryActivity is an item of the repository activity API, which the
+// go-github client does not support
+type repositoryActivity struct {
+	ID           int64             `json:"id"`
+	NodeID       string            `json:"node_id"`
+	Before       string            `json:"before"`
+	After        string            `json:"after"`
+	Ref          string            `json:"ref"`
+	Timestamp    *github.Timestamp `json:"timestamp"`
+	ActivityType string            `json:"activity_type"`
+	Actor        *github.User      `json:"actor"`
+}
+
+// repositoryActivityTimePeriods are the time periods the activity can be
+// filtered by, with the shortest duration each of them is guaranteed to cover
+var repositoryActivityTimePeriods = []struct {
+	name     string
+	duration time.Duration
+}{
+	{"day", 24 * time.Hour},
+	{"week", 7 * 24 * time.Hour},
+	{"month", 28 * 24 * time.Hour},
+	{"quarter", 89 * 24 * time.Hour},
+	{"year", 365 * 24 * time.Hour},
+}
+
+func tableGitHubRepositoryActivityList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+
+	quals := d.EqualsQuals
+	fullName := quals["repository_full_name"].GetStringValue()
+	owner, repo := parseRepoFullName(fullName)
+
+	params := url.Values{}
+	if quals["activity_type"] != nil {
+		params.Set("activity_type", quals["activity_type"].GetStringValue())
+	}
+	if quals["ref"] != nil {
+		params.Set("ref", quals["ref"].GetStringValue())
+	}
+	if quals["actor_login"] != nil {
+		params.Set("actor", quals["actor_login"].GetStringValue())
+	}
+
+	// The activity can only be filtered by a time period ending now, so the
+	// shortest period covering the lower bound of the timestamp is used
+	if d.Quals["timestamp"] != nil {
+		for _, q := range d.Quals["timestamp"].Quals {
+			since := time.Since(q.Value.GetTimestampValue().AsTime())
+			for _, period := range repositoryActivityTimePeriods {
+				if since <= period.duration {
+					params.Set("time_period", period.name)
+					break
+				}
+			}
+		}
+	}
+
+	opts := &github.ListCursorOptions{PerPage: 100}
+
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit < int64(opts.PerPage) {
+			opts.PerPage = int(*limit)
+		}
+	}
+	params.Set("per_page", fmt.Sprint(opts.PerPage))
+
+	for {
+		req, err := client.NewRequest("GET", fmt.Sprintf("repos/%s/%s/activity?%s", owner, repo, params.Encode()), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var activities []*repositoryActivity
+		resp, err := client.Do(ctx, req, &activities)
+		if err != nil {
+			plugin.Logger(ctx).Error("github_repository_activity", "api_error", err)
+			return nil, err
+		}
+
+		for _, i := range activities {
+			if i != nil {
+				d.StreamListItem(ctx, i)
+			}
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if resp.After == "" {
+			break
+		}
+
+		params.Set("after", resp.After)
+	}
+
+	return nil, nil
+}
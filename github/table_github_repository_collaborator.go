@@ -8,6 +8,7 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"slices"
 	"strings"
 )
 
@@ -17,6 +18,12 @@ func gitHubRepositoryCollaboratorColumns() []*plugin.Column {
 		{Name: "affiliation", Type: proto.ColumnType_STRING, Description: "Affiliation filter - valid values 'ALL' (default), 'OUTSIDE', 'DIRECT'.", Transform: transform.FromQual("affiliation"), Default: "ALL"},
 		{Name: "permission", Type: proto.ColumnType_STRING, Description: "The permission the collaborator has on the repository."},
 		{Name: "user_login", Type: proto.ColumnType_STRING, Description: "The login of the collaborator", Transform: transform.FromField("Node.Login")},
+		{Name: "role_name", Type: proto.ColumnType_STRING, Description: "The name of the role granting the collaborator's effective permission, e.g. admin, maintain, write, triage, read or the name of a custom repository role.", Transform: transform.FromValue().Transform(collaboratorEffectiveRoleName)},
+		{Name: "permission_sources", Type: proto.ColumnType_JSON, Description: "The sources the collaborator's permissions were granted from, i.e. the repository itself, teams or the organization's base permission.", Transform: transform.FromField("PermissionSources").NullIfZero()},
+		{Name: "is_direct", Type: proto.ColumnType_BOOL, Description: "If true, the collaborator was granted permission directly on the repository.", Transform: transform.FromField("PermissionSources").Transform(collaboratorHasPermissionSourceOfType("Repository"))},
+		{Name: "is_via_team", Type: proto.ColumnType_BOOL, Description: "If true, the collaborator was granted permission via membership of a team.", Transform: transform.FromField("PermissionSources").Transform(collaboratorHasPermissionSourceOfType("Team"))},
+		{Name: "is_via_organization", Type: proto.ColumnType_BOOL, Description: "If true, the collaborator was granted permission via the organization, e.g. as an owner or by the base permission.", Transform: transform.FromField("PermissionSources").Transform(collaboratorHasPermissionSourceOfType("Organization"))},
+		{Name: "is_outside_collaborator", Type: proto.ColumnType_BOOL, Description: "If true, the collaborator is an outside collaborator, i.e. not a member of the organization that owns the repository.", Transform: transform.FromField("IsOutsideCollaborator")},
 	}
 }
 
@@ -69,14 +76,26 @@ func tableGitHubRepositoryCollaboratorList(ctx context.Context, d *plugin.QueryD
 			Collaborators struct {
 				TotalCount int
 				PageInfo   models.PageInfo
-				Edges      []struct {
-					Permission githubv4.RepositoryPermission
-					Node       models.BasicUser
-				}
+				Edges      []models.RepositoryCollaborator
 			} `graphql:"collaborators(first: $pageSize, after: $cursor, affiliation: $affiliation)"`
 		} `graphql:"repository(owner: $owner, name: $repo)"`
 	}
 
+	// Outside collaborators can only be identified with a separate filtered listing, so only fetch them if required
+	var outsideCollaborators map[string]bool
+	if slices.Contains(d.QueryContext.Columns, "is_outside_collaborator") {
+		if affiliation == githubv4.CollaboratorAffiliationOutside {
+			outsideCollaborators = map[string]bool{}
+		} else {
+			var err error
+			outsideCollaborators, err = listRepositoryOutsideCollaboratorLogins(ctx, d, owner, repoName)
+			if err != nil {
+				plugin.Logger(ctx).Error("github_repository_collaborator", "api_error", err, "repository", fullName)
+				return nil, err
+			}
+		}
+	}
+
 	pageSize := adjustPageSize(100, d.QueryContext.Limit)
 	variables := map[string]interface{}{
 		"owner":       githubv4.String(owner),
@@ -96,7 +115,12 @@ func tableGitHubRepositoryCollaboratorList(ctx context.Context, d *plugin.QueryD
 		}
 
 		for _, c := range query.Repository.Collaborators.Edges {
-			d.StreamListItem(ctx, c)
+			row := repositoryCollaborator{RepositoryCollaborator: c}
+			if outsideCollaborators != nil {
+				isOutside := affiliation == githubv4.CollaboratorAffiliationOutside || outsideCollaborators[c.Node.Login]
+				row.IsOutsideCollaborator = &isOutside
+			}
+			d.StreamListItem(ctx, row)
 
 			// Context can be cancelled due to manual cancellation or the limit has been hit
 			if d.RowsRemaining(ctx) == 0 {
@@ -112,3 +136,88 @@ func tableGitHubRepositoryCollaboratorList(ctx context.Context, d *plugin.QueryD
 
 	return nil, nil
 }
+
+type repositoryCollaborator struct {
+	models.RepositoryCollaborator
+	IsOutsideCollaborator *bool
+}
+
+func listRepositoryOutsideCollaboratorLogins(ctx context.Context, d *plugin.QueryData, owner string, repoName string) (map[string]bool, error) {
+	var query struct {
+		RateLimit  models.RateLimit
+		Repository struct {
+			Collaborators struct {
+				PageInfo models.PageInfo
+				Nodes    []struct {
+					Login string
+				}
+			} `graphql:"collaborators(first: $pageSize, after: $cursor, affiliation: OUTSIDE)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner":    githubv4.String(owner),
+		"repo":     githubv4.String(repoName),
+		"pageSize": githubv4.Int(100),
+		"cursor":   (*githubv4.String)(nil),
+	}
+
+	logins := map[string]bool{}
+	client := connectV4(ctx, d)
+	for {
+		err := client.Query(ctx, &query, variables)
+		plugin.Logger(ctx).Debug(rateLimitLogString("github_repository_collaborator", &query.RateLimit))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, c := range query.Repository.Collaborators.Nodes {
+			logins[c.Login] = true
+		}
+
+		if !query.Repository.Collaborators.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Repository.Collaborators.PageInfo.EndCursor)
+	}
+
+	return logins, nil
+}
+
+// collaboratorEffectiveRoleName returns the role name of the permission source which grants the effective permission
+func collaboratorEffectiveRoleName(_ context.Context, input *transform.TransformData) (interface{}, error) {
+	c, ok := input.Value.(repositoryCollaborator)
+	if !ok {
+		return nil, nil
+	}
+
+	// Prefer the source whose role matches the effective permission, falling back to the first source
+	// with a role name, e.g. a custom repository role
+	effective := strings.ToLower(string(c.Permission))
+	for _, s := range c.PermissionSources {
+		if strings.EqualFold(s.RoleName, effective) {
+			return s.RoleName, nil
+		}
+	}
+	for _, s := range c.PermissionSources {
+		if s.RoleName != "" {
+			return s.RoleName, nil
+		}
+	}
+	return effective, nil
+}
+
+func collaboratorHasPermissionSourceOfType(sourceType string) transform.TransformFunc {
+	return func(_ context.Context, input *transform.TransformData) (interface{}, error) {
+		sources, ok := input.Value.([]models.PermissionSource)
+		if !ok {
+			return nil, nil
+		}
+		for _, s := range sources {
+			if s.Source.Type == sourceType {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
@@ -0,0 +1,99 @@
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v55/github"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubRepositoryContent() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_repository_content",
+		Description: "Contents of a file or directory in the given repository.",
+		List: &plugin.ListConfig{
+			Hydrate:           tableGitHubRepositoryContentList,
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "repository_full_name", Require: plugin.Required},
+				{Name: "repository_content_path", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "ref", Require: plugin.Optional, CacheMatch: "exact"},
+			},
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromQual("repository_full_name"), Description: "Full name of the repository that contains the content."},
+			{Name: "repository_content_path", Type: proto.ColumnType_STRING, Transform: transform.FromQual("repository_content_path"), Description: "The requested path in the repository. Defaults to the root of the repository."},
+			{Name: "ref", Type: proto.ColumnType_STRING, Transform: transform.FromQual("ref"), Description: "The name of the commit, branch or tag to get the content at. Defaults to the repository's default branch."},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the file or directory."},
+			{Name: "path", Type: proto.ColumnType_STRING, Description: "The path of the file or directory in the repository."},
+			{Name: "type", Type: proto.ColumnType_STRING, Description: "The type of the content, one of file, dir, symlink or submodule."},
+			// Other columns
+			{Name: "sha", Type: proto.ColumnType_STRING, Transform: transform.FromField("SHA"), Description: "SHA1 of the blob or tree."},
+			{Name: "size", Type: proto.ColumnType_INT, Description: "Size of the file in bytes."},
+			{Name: "encoding", Type: proto.ColumnType_STRING, Description: "The encoding of the raw content, e.g. base64. Only set when a file path is requested."},
+			{Name: "content", Type: proto.ColumnType_STRING, Transform: transform.FromValue().Transform(decodeRepositoryContent), Description: "The decoded content of the file. Only set when a file path is requested, and the file is at most 1 MB."},
+			{Name: "target", Type: proto.ColumnType_STRING, Description: "The target of the symlink, if the content is a symlink."},
+			{Name: "submodule_git_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("SubmoduleGitURL"), Description: "The git URL of the submodule, if the content is a submodule."},
+			{Name: "url", Type: proto.ColumnType_STRING, Transform: transform.FromField("URL"), Description: "The API URL of the content."},
+			{Name: "git_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("GitURL"), Description: "The git API URL of the blob or tree."},
+			{Name: "html_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("HTMLURL"), Description: "The URL of the content in the GitHub UI."},
+			{Name: "download_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("DownloadURL"), Description: "The URL to download the raw file."},
+		},
+	}
+}
+
+func tableGitHubRepositoryContentList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	client := connect(ctx, d)
+
+	quals := d.EqualsQuals
+	fullName := quals["repository_full_name"].GetStringValue()
+	path := quals["repository_content_path"].GetStringValue()
+	owner, repo := parseRepoFullName(fullName)
+	opts := &github.RepositoryContentGetOptions{Ref: quals["ref"].GetStringValue()}
+
+	fileContent, directoryContent, _, err := client.Repositories.GetContents(ctx, owner, repo, path, opts)
+	if err != nil {
+		logger.Error("github_repository_content.tableGitHubRepositoryContentList", "api_error", err)
+		return nil, err
+	}
+
+	if fileContent != nil {
+		d.StreamListItem(ctx, fileContent)
+		return nil, nil
+	}
+
+	for _, c := range directoryContent {
+		if c != nil {
+			d.StreamListItem(ctx, c)
+		}
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// decodeRepositoryContent:: Decode the content of a file, directory entries have no content and files over 1 MB are
+// returned without content, with an encoding of "none"
+func decodeRepositoryContent(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	content, ok := d.Value.(*github.RepositoryContent)
+	if !ok || content.Content == nil || content.GetEncoding() == "none" {
+		return nil, nil
+	}
+
+	decoded, err := content.GetContent()
+	if err != nil {
+		plugin.Logger(ctx).Warn("github_repository_content.decodeRepositoryContent", "decoding_error", err)
+		return nil, nil
+	}
+
+	return decoded, nil
+}
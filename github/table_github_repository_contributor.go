@@ -0,0 +1,165 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/go-github/v55/github"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubRepositoryContributor() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_repository_contributor",
+		Description: "Contributors to the given repository, with their commit counts and weekly code frequency statistics.",
+		List: &plugin.ListConfig{
+			KeyColumns:        plugin.SingleColumn("repository_full_name"),
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubRepositoryContributorList,
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromQual("repository_full_name"), Description: "Full name of the repository that contains the contributor."},
+			{Name: "login", Type: proto.ColumnType_STRING, Transform: transform.FromField("Contributor.Login"), Description: "The login name of the contributor."},
+			{Name: "contributions", Type: proto.ColumnType_INT, Transform: transform.FromField("Contributor.Contributions"), Description: "The number of commits the contributor has made to the default branch of the repository."},
+			// Other columns
+			{Name: "id", Type: proto.ColumnType_INT, Transform: transform.FromField("Contributor.ID"), Description: "The ID of the contributor."},
+			{Name: "node_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Contributor.NodeID"), Description: "The node ID of the contributor."},
+			{Name: "type", Type: proto.ColumnType_STRING, Transform: transform.FromField("Contributor.Type"), Description: "The type of the contributor, e.g. User or Bot."},
+			{Name: "site_admin", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Contributor.SiteAdmin"), Description: "If true, the contributor is a site administrator."},
+			{Name: "avatar_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("Contributor.AvatarURL"), Description: "The URL of the contributor's avatar."},
+			{Name: "html_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("Contributor.HTMLURL"), Description: "The URL of the contributor's profile in the GitHub UI."},
+			// Columns from the statistics API, only fetched when requested
+			{Name: "total_commits", Type: proto.ColumnType_INT, Transform: transform.FromField("Stats.Total"), Description: "The total number of commits authored by the contributor. Only available for the top 100 contributors."},
+			{Name: "total_additions", Type: proto.ColumnType_INT, Transform: transform.FromField("Stats.Weeks").Transform(sumContributorWeeklyAdditions), Description: "The total number of lines added by the contributor. Only available for the top 100 contributors."},
+			{Name: "total_deletions", Type: proto.ColumnType_INT, Transform: transform.FromField("Stats.Weeks").Transform(sumContributorWeeklyDeletions), Description: "The total number of lines deleted by the contributor. Only available for the top 100 contributors."},
+			{Name: "weeks", Type: proto.ColumnType_JSON, Transform: transform.FromField("Stats.Weeks").NullIfZero(), Description: "The weekly number of additions, deletions and commits authored by the contributor. Only available for the top 100 contributors."},
+		},
+	}
+}
+
+type repositoryContributor struct {
+	Contributor *github.Contributor
+	Stats       *github.ContributorStats
+}
+
+// Columns which require the contributor statistics to be fetched
+var repositoryContributorStatsColumns = []string{"total_commits", "total_additions", "total_deletions", "weeks"}
+
+func tableGitHubRepositoryContributorList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+	fullName := d.EqualsQuals["repository_full_name"].GetStringValue()
+	owner, repo := parseRepoFullName(fullName)
+
+	// The statistics are expensive to compute, so only fetch them if required
+	var stats map[string]*github.ContributorStats
+	for _, c := range d.QueryContext.Columns {
+		if slices.Contains(repositoryContributorStatsColumns, c) {
+			var err error
+			stats, err = listRepositoryContributorStats(ctx, client, owner, repo)
+			if err != nil {
+				plugin.Logger(ctx).Error("github_repository_contributor", "api_error", err)
+				return nil, err
+			}
+			break
+		}
+	}
+
+	opts := &github.ListContributorsOptions{ListOptions: github.ListOptions{PerPage: 100}}
+
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit < int64(opts.PerPage) {
+			opts.PerPage = int(*limit)
+		}
+	}
+
+	for {
+		contributors, resp, err := client.Repositories.ListContributors(ctx, owner, repo, opts)
+		if err != nil {
+			plugin.Logger(ctx).Error("github_repository_contributor", "api_error", err)
+			return nil, err
+		}
+
+		for _, c := range contributors {
+			if c != nil {
+				d.StreamListItem(ctx, repositoryContributor{Contributor: c, Stats: stats[c.GetLogin()]})
+			}
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return nil, nil
+}
+
+// listRepositoryContributorStats returns the contributor statistics keyed by login. GitHub computes
+// the statistics in the background and responds with a 202 until they are ready, so retry for a while.
+func listRepositoryContributorStats(ctx context.Context, client *github.Client, owner string, repo string) (map[string]*github.ContributorStats, error) {
+	for attempt := 0; attempt < 10; attempt++ {
+		stats, _, err := client.Repositories.ListContributorsStats(ctx, owner, repo)
+		var acceptedErr *github.AcceptedError
+		if errors.As(err, &acceptedErr) {
+			plugin.Logger(ctx).Debug("github_repository_contributor.listRepositoryContributorStats", "statistics_pending", repo, "attempt", attempt)
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(2 * time.Second):
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		result := make(map[string]*github.ContributorStats, len(stats))
+		for _, s := range stats {
+			if s != nil && s.Author != nil {
+				result[s.Author.GetLogin()] = s
+			}
+		}
+		return result, nil
+	}
+
+	// The statistics are still being computed, return the contributors without them
+	plugin.Logger(ctx).Warn("github_repository_contributor.listRepositoryContributorStats", "statistics_unavailable", repo)
+	return nil, nil
+}
+
+func sumContributorWeeklyAdditions(_ context.Context, input *transform.TransformData) (interface{}, error) {
+	weeks, ok := input.Value.([]*github.WeeklyStats)
+	if !ok || weeks == nil {
+		return nil, nil
+	}
+	total := 0
+	for _, w := range weeks {
+		total += w.GetAdditions()
+	}
+	return total, nil
+}
+
+func sumContributorWeeklyDeletions(_ context.Context, input *transform.TransformData) (interface{}, error) {
+	weeks, ok := input.Value.([]*github.WeeklyStats)
+	if !ok || weeks == nil {
+		return nil, nil
+	}
+	total := 0
+	for _, w := range weeks {
+		total += w.GetDeletions()
+	}
+	return total, nil
+}
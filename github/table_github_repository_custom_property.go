@@ -0,0 +1,62 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type repositoryCustomPropertyValue struct {
+	PropertyName string      `json:"property_name"`
+	Value        interface{} `json:"value"`
+}
+
+func tableGitHubRepositoryCustomProperty() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_repository_custom_property",
+		Description: "Custom property values set on the given repository.",
+		List: &plugin.ListConfig{
+			KeyColumns:        plugin.SingleColumn("repository_full_name"),
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubRepositoryCustomPropertyList,
+		},
+		Columns: []*plugin.Column{
+			{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromQual("repository_full_name"), Description: "Full name of the repository the property value is set on."},
+			{Name: "property_name", Type: proto.ColumnType_STRING, Description: "The name of the property."},
+			{Name: "value", Type: proto.ColumnType_JSON, Description: "The value of the property, a string or an array of strings for multi_select properties."},
+		},
+	}
+}
+
+func tableGitHubRepositoryCustomPropertyList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+
+	fullName := d.EqualsQuals["repository_full_name"].GetStringValue()
+	owner, repo := parseRepoFullName(fullName)
+
+	req, err := client.NewRequest("GET", fmt.Sprintf("repos/%v/%v/properties/values", owner, repo), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []*repositoryCustomPropertyValue
+	_, err = client.Do(ctx, req, &values)
+	if err != nil {
+		plugin.Logger(ctx).Error("github_repository_custom_property", "api_error", err)
+		return nil, err
+	}
+
+	for _, v := range values {
+		d.StreamListItem(ctx, v)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
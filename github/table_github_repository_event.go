@@ -0,0 +1,77 @@
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v55/github"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubRepositoryEvent() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_repository_event",
+		Description: "Events which occurred in the given repository, e.g. pushes, releases, issues and member changes.",
+		List: &plugin.ListConfig{
+			KeyColumns:        plugin.SingleColumn("repository_full_name"),
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubRepositoryEventList,
+		},
+		Columns: gitHubRepositoryEventColumns(),
+	}
+}
+
+// gitHubRepositoryEventColumns returns the event columns with the repository taken from the qual, as the events keep
+// the name of the repository at the time of the event
+func gitHubRepositoryEventColumns() []*plugin.Column {
+	columns := gitHubEventColumns()
+	for _, column := range columns {
+		if column.Name == "repository_full_name" {
+			column.Transform = transform.FromQual("repository_full_name")
+		}
+	}
+	return columns
+}
+
+func tableGitHubRepositoryEventList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+
+	fullName := d.EqualsQuals["repository_full_name"].GetStringValue()
+	owner, repo := parseRepoFullName(fullName)
+	opts := &github.ListOptions{PerPage: 100}
+
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit < int64(opts.PerPage) {
+			opts.PerPage = int(*limit)
+		}
+	}
+
+	for {
+		events, resp, err := client.Activity.ListRepositoryEvents(ctx, owner, repo, opts)
+		if err != nil {
+			plugin.Logger(ctx).Error("github_repository_event", "api_error", err)
+			return nil, err
+		}
+
+		for _, e := range events {
+			if e != nil {
+				d.StreamListItem(ctx, e)
+			}
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,127 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/turbot/steampipe-plugin-github/github/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubRepositoryFork() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_repository_fork",
+		Description: "Forks of the given repository.",
+		List: &plugin.ListConfig{
+			KeyColumns:        plugin.SingleColumn("repository_full_name"),
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubRepositoryForkList,
+		},
+		Columns: []*plugin.Column{
+			{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromQual("repository_full_name"), Description: "Full name of the repository the forks were created from."},
+			{Name: "name_with_owner", Type: proto.ColumnType_STRING, Transform: transform.FromField("Fork.NameWithOwner"), Description: "The fork's name with owner."},
+			{Name: "owner_login", Type: proto.ColumnType_STRING, Transform: transform.FromField("Fork.Owner.Login"), Description: "Login of the fork owner."},
+			{Name: "id", Type: proto.ColumnType_INT, Transform: transform.FromField("Fork.Id"), Description: "The numeric ID of the fork."},
+			{Name: "node_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Fork.NodeId"), Description: "The node ID of the fork."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Fork.CreatedAt").NullIfZero().Transform(convertTimestamp), Description: "Timestamp when the fork was created."},
+			{Name: "pushed_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Fork.PushedAt").NullIfZero().Transform(convertTimestamp), Description: "Timestamp when the fork was last pushed to."},
+			{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Fork.UpdatedAt").NullIfZero().Transform(convertTimestamp), Description: "Timestamp when the fork was last updated."},
+			{Name: "stargazer_count", Type: proto.ColumnType_INT, Transform: transform.FromField("Fork.StargazerCount"), Description: "Count of how many stargazers there are on the fork."},
+			{Name: "fork_count", Type: proto.ColumnType_INT, Transform: transform.FromField("Fork.ForkCount"), Description: "Count of how many forks there are of the fork."},
+			{Name: "is_archived", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Fork.IsArchived"), Description: "If true, the fork is unmaintained (archived)."},
+			{Name: "is_private", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Fork.IsPrivate"), Description: "If true, the fork is private or internal."},
+			{Name: "visibility", Type: proto.ColumnType_STRING, Transform: transform.FromField("Fork.Visibility"), Description: "Indicates the fork's visibility level."},
+			{Name: "url", Type: proto.ColumnType_STRING, Transform: transform.FromField("Fork.Url"), Description: "The URL of the fork."},
+			{Name: "default_branch", Type: proto.ColumnType_STRING, Transform: transform.FromField("Fork.DefaultBranchRef.Name"), Description: "The name of the fork's default branch."},
+			// Columns from the compare API - hydrates
+			{Name: "status", Type: proto.ColumnType_STRING, Hydrate: hydrateRepositoryForkComparison, Transform: transform.FromField("Status"), Description: "The status of the fork's default branch compared to the upstream default branch, one of diverged, ahead, behind or identical."},
+			{Name: "ahead_by", Type: proto.ColumnType_INT, Hydrate: hydrateRepositoryForkComparison, Transform: transform.FromField("AheadBy"), Description: "Number of commits the fork's default branch is ahead of the upstream default branch."},
+			{Name: "behind_by", Type: proto.ColumnType_INT, Hydrate: hydrateRepositoryForkComparison, Transform: transform.FromField("BehindBy"), Description: "Number of commits the fork's default branch is behind the upstream default branch."},
+		},
+	}
+}
+
+type repositoryFork struct {
+	Fork                  models.RepositoryFork
+	UpstreamDefaultBranch string
+}
+
+func tableGitHubRepositoryForkList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	fullName := d.EqualsQuals["repository_full_name"].GetStringValue()
+	owner, repo := parseRepoFullName(fullName)
+
+	var query struct {
+		RateLimit  models.RateLimit
+		Repository struct {
+			DefaultBranchRef models.BasicRef
+			Forks            struct {
+				TotalCount int
+				PageInfo   models.PageInfo
+				Nodes      []models.RepositoryFork
+			} `graphql:"forks(first: $pageSize, after: $cursor)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+
+	pageSize := adjustPageSize(100, d.QueryContext.Limit)
+	variables := map[string]interface{}{
+		"owner":    githubv4.String(owner),
+		"repo":     githubv4.String(repo),
+		"pageSize": githubv4.Int(pageSize),
+		"cursor":   (*githubv4.String)(nil),
+	}
+
+	client := connectV4(ctx, d)
+	for {
+		err := client.Query(ctx, &query, variables)
+		plugin.Logger(ctx).Debug(rateLimitLogString("github_repository_fork", &query.RateLimit))
+		if err != nil {
+			plugin.Logger(ctx).Error("github_repository_fork", "api_error", err)
+			return nil, err
+		}
+
+		for _, fork := range query.Repository.Forks.Nodes {
+			d.StreamListItem(ctx, repositoryFork{Fork: fork, UpstreamDefaultBranch: query.Repository.DefaultBranchRef.Name})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if !query.Repository.Forks.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Repository.Forks.PageInfo.EndCursor)
+	}
+
+	return nil, nil
+}
+
+func hydrateRepositoryForkComparison(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	fork := h.Item.(repositoryFork)
+	if fork.UpstreamDefaultBranch == "" || fork.Fork.DefaultBranchRef.Name == "" {
+		return nil, nil
+	}
+
+	fullName := d.EqualsQuals["repository_full_name"].GetStringValue()
+	owner, repo := parseRepoFullName(fullName)
+	head := fmt.Sprintf("%s:%s", fork.Fork.Owner.Login, fork.Fork.DefaultBranchRef.Name)
+
+	client := connect(ctx, d)
+	comparison, _, err := client.Repositories.CompareCommits(ctx, owner, repo, fork.UpstreamDefaultBranch, head, nil)
+	if err != nil {
+		// The branch of the fork may have been deleted or share no history
+		// with the upstream branch, which only nulls the comparison of the fork
+		if isNotFoundError([]string{"404", "422"})(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("github_repository_fork.hydrateRepositoryForkComparison", "api_error", err, "fork", fork.Fork.NameWithOwner)
+		return nil, err
+	}
+
+	return comparison, nil
+}
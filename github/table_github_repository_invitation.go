@@ -0,0 +1,92 @@
+package github
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-github/v55/github"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// Invitations to collaborate on a repository expire after 7 days
+const repositoryInvitationValidity = 7 * 24 * time.Hour
+
+func tableGitHubRepositoryInvitation() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_repository_invitation",
+		Description: "Pending invitations to collaborate on the given repository.",
+		List: &plugin.ListConfig{
+			KeyColumns:        plugin.SingleColumn("repository_full_name"),
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubRepositoryInvitationList,
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromQual("repository_full_name"), Description: "Full name of the repository the invitation is for."},
+			{Name: "id", Type: proto.ColumnType_INT, Transform: transform.FromField("ID"), Description: "Unique ID of the invitation."},
+			{Name: "invitee_login", Type: proto.ColumnType_STRING, Transform: transform.FromField("Invitee.Login"), Description: "The login name of the user invited to collaborate."},
+			{Name: "inviter_login", Type: proto.ColumnType_STRING, Transform: transform.FromField("Inviter.Login"), Description: "The login name of the user who sent the invitation."},
+			{Name: "permissions", Type: proto.ColumnType_STRING, Description: "The permission the invitee will have on the repository, e.g. read, triage, write, maintain or admin."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("CreatedAt").Transform(convertTimestamp), Description: "Time when the invitation was created."},
+			{Name: "expired", Type: proto.ColumnType_BOOL, Transform: transform.FromField("CreatedAt").Transform(isRepositoryInvitationExpired), Description: "If true, the invitation is older than 7 days and has expired."},
+			// Other columns
+			{Name: "invitee", Type: proto.ColumnType_JSON, Transform: transform.FromField("Invitee").NullIfZero(), Description: "The user invited to collaborate."},
+			{Name: "inviter", Type: proto.ColumnType_JSON, Transform: transform.FromField("Inviter").NullIfZero(), Description: "The user who sent the invitation."},
+			{Name: "html_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("HTMLURL"), Description: "The URL of the invitation in the GitHub UI."},
+			{Name: "url", Type: proto.ColumnType_STRING, Transform: transform.FromField("URL"), Description: "The API URL of the invitation."},
+		},
+	}
+}
+
+func tableGitHubRepositoryInvitationList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+
+	fullName := d.EqualsQuals["repository_full_name"].GetStringValue()
+	owner, repo := parseRepoFullName(fullName)
+	opts := &github.ListOptions{PerPage: 100}
+
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit < int64(opts.PerPage) {
+			opts.PerPage = int(*limit)
+		}
+	}
+
+	for {
+		invitations, resp, err := client.Repositories.ListInvitations(ctx, owner, repo, opts)
+		if err != nil {
+			plugin.Logger(ctx).Error("github_repository_invitation", "api_error", err)
+			return nil, err
+		}
+
+		for _, i := range invitations {
+			if i != nil {
+				d.StreamListItem(ctx, i)
+			}
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return nil, nil
+}
+
+func isRepositoryInvitationExpired(_ context.Context, input *transform.TransformData) (interface{}, error) {
+	createdAt, ok := input.Value.(*github.Timestamp)
+	if !ok || createdAt == nil {
+		return nil, nil
+	}
+	return time.Since(createdAt.Time) > repositoryInvitationValidity, nil
+}
@@ -0,0 +1,96 @@
+package github
+
+import (
+	"context"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/turbot/steampipe-plugin-github/github/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubRepositoryLanguage() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_repository_language",
+		Description: "Languages detected in the given repository, with the number of bytes of code written in each.",
+		List: &plugin.ListConfig{
+			KeyColumns:        plugin.SingleColumn("repository_full_name"),
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubRepositoryLanguageList,
+		},
+		Columns: []*plugin.Column{
+			{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromQual("repository_full_name"), Description: "Full name of the repository that contains the language."},
+			{Name: "name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Node.Name"), Description: "The name of the language."},
+			{Name: "color", Type: proto.ColumnType_STRING, Transform: transform.FromField("Node.Color"), Description: "The color defined for the language."},
+			{Name: "bytes", Type: proto.ColumnType_INT, Transform: transform.FromField("Size"), Description: "The number of bytes of code written in the language."},
+			{Name: "percentage", Type: proto.ColumnType_DOUBLE, Description: "The percentage of the repository's code written in the language."},
+		},
+	}
+}
+
+type repositoryLanguage struct {
+	Size       int
+	Node       models.Language
+	Percentage float64
+}
+
+func tableGitHubRepositoryLanguageList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	fullName := d.EqualsQuals["repository_full_name"].GetStringValue()
+	owner, repo := parseRepoFullName(fullName)
+
+	var query struct {
+		RateLimit  models.RateLimit
+		Repository struct {
+			Languages struct {
+				TotalCount int
+				TotalSize  int
+				PageInfo   models.PageInfo
+				Edges      []struct {
+					Size int
+					Node models.Language
+				}
+			} `graphql:"languages(first: $pageSize, after: $cursor, orderBy: {field: SIZE, direction: DESC})"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+
+	pageSize := adjustPageSize(100, d.QueryContext.Limit)
+	variables := map[string]interface{}{
+		"owner":    githubv4.String(owner),
+		"repo":     githubv4.String(repo),
+		"pageSize": githubv4.Int(pageSize),
+		"cursor":   (*githubv4.String)(nil),
+	}
+
+	client := connectV4(ctx, d)
+	for {
+		err := client.Query(ctx, &query, variables)
+		plugin.Logger(ctx).Debug(rateLimitLogString("github_repository_language", &query.RateLimit))
+		if err != nil {
+			plugin.Logger(ctx).Error("github_repository_language", "api_error", err)
+			return nil, err
+		}
+
+		totalSize := query.Repository.Languages.TotalSize
+		for _, l := range query.Repository.Languages.Edges {
+			row := repositoryLanguage{Size: l.Size, Node: l.Node}
+			if totalSize > 0 {
+				row.Percentage = float64(l.Size) * 100 / float64(totalSize)
+			}
+			d.StreamListItem(ctx, row)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if !query.Repository.Languages.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Repository.Languages.PageInfo.EndCursor)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,111 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubRepositorySecuritySettings() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_repository_security_settings",
+		Description: "Security and analysis settings of the given repository, such as secret scanning and Dependabot security updates.",
+		List: &plugin.ListConfig{
+			Hydrate:           tableGitHubRepositorySecuritySettingsList,
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			KeyColumns:        plugin.SingleColumn("repository_full_name"),
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromQual("repository_full_name"), Description: "Full name of the repository."},
+			{Name: "advanced_security_enabled", Type: proto.ColumnType_BOOL, Transform: transform.FromField("SecurityAndAnalysis.AdvancedSecurity.Status").Transform(isSecurityFeatureEnabled), Description: "If true, GitHub Advanced Security is enabled. Null if the setting is not available for the repository."},
+			{Name: "secret_scanning_enabled", Type: proto.ColumnType_BOOL, Transform: transform.FromField("SecurityAndAnalysis.SecretScanning.Status").Transform(isSecurityFeatureEnabled), Description: "If true, secret scanning is enabled. Null if the setting is not available for the repository."},
+			{Name: "secret_scanning_push_protection_enabled", Type: proto.ColumnType_BOOL, Transform: transform.FromField("SecurityAndAnalysis.SecretScanningPushProtection.Status").Transform(isSecurityFeatureEnabled), Description: "If true, pushes containing secrets are blocked. Null if the setting is not available for the repository."},
+			{Name: "dependabot_security_updates_enabled", Type: proto.ColumnType_BOOL, Transform: transform.FromField("SecurityAndAnalysis.DependabotSecurityUpdates.Status").Transform(isSecurityFeatureEnabled), Description: "If true, Dependabot opens pull requests to update vulnerable dependencies. Null if the setting is not available for the repository."},
+			{Name: "vulnerability_alerts_enabled", Type: proto.ColumnType_BOOL, Hydrate: tableGitHubRepositorySecuritySettingsVulnerabilityAlerts, Transform: transform.FromValue(), Description: "If true, Dependabot alerts are enabled."},
+			{Name: "private_vulnerability_reporting_enabled", Type: proto.ColumnType_BOOL, Hydrate: tableGitHubRepositorySecuritySettingsPrivateVulnerabilityReporting, Transform: transform.FromValue(), Description: "If true, security researchers can privately report vulnerabilities to the maintainers. Null if the current user is not an admin of the repository."},
+			// Other columns
+			{Name: "visibility", Type: proto.ColumnType_STRING, Description: "The visibility of the repository, which determines the security features available to it."},
+			{Name: "security_and_analysis", Type: proto.ColumnType_JSON, Transform: transform.FromField("SecurityAndAnalysis").NullIfZero(), Description: "The security and analysis settings as returned by the API."},
+		},
+	}
+}
+
+func tableGitHubRepositorySecuritySettingsList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	fullName := d.EqualsQuals["repository_full_name"].GetStringValue()
+	owner, repo := parseRepoFullName(fullName)
+
+	// The security_and_analysis block is only returned to users with admin
+	// permissions on the repository
+	repository, err := getRepositoryV3(ctx, d, owner, repo)
+	if err != nil {
+		plugin.Logger(ctx).Error("github_repository_security_settings", "api_error", err)
+		return nil, err
+	}
+
+	if repository != nil {
+		d.StreamListItem(ctx, repository)
+	}
+
+	return nil, nil
+}
+
+func tableGitHubRepositorySecuritySettingsVulnerabilityAlerts(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+
+	fullName := d.EqualsQuals["repository_full_name"].GetStringValue()
+	owner, repo := parseRepoFullName(fullName)
+
+	enabled, _, err := client.Repositories.GetVulnerabilityAlerts(ctx, owner, repo)
+	if err != nil {
+		plugin.Logger(ctx).Error("github_repository_security_settings.tableGitHubRepositorySecuritySettingsVulnerabilityAlerts", "api_error", err)
+		return nil, err
+	}
+
+	return enabled, nil
+}
+
+func tableGitHubRepositorySecuritySettingsPrivateVulnerabilityReporting(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+
+	fullName := d.EqualsQuals["repository_full_name"].GetStringValue()
+	owner, repo := parseRepoFullName(fullName)
+
+	// The go-github client does not support reading the private vulnerability
+	// reporting setting yet, so it is fetched with a raw request
+	req, err := client.NewRequest("GET", fmt.Sprintf("repos/%v/%v/private-vulnerability-reporting", owner, repo), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Enabled bool `json:"enabled"`
+	}
+	_, err = client.Do(ctx, req, &result)
+	if err != nil {
+		// The setting can only be read by repository admins
+		if isNotFoundError([]string{"403", "404"})(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("github_repository_security_settings.tableGitHubRepositorySecuritySettingsPrivateVulnerabilityReporting", "api_error", err)
+		return nil, err
+	}
+
+	return result.Enabled, nil
+}
+
+func isSecurityFeatureEnabled(_ context.Context, input *transform.TransformData) (interface{}, error) {
+	switch status := input.Value.(type) {
+	case *string:
+		if status == nil {
+			return nil, nil
+		}
+		return *status == "enabled", nil
+	case string:
+		return status == "enabled", nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,81 @@
+package github
+
+import (
+	"context"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/turbot/steampipe-plugin-github/github/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubRepositoryTopic() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_repository_topic",
+		Description: "Topics associated with the given repository.",
+		List: &plugin.ListConfig{
+			KeyColumns:        plugin.SingleColumn("repository_full_name"),
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubRepositoryTopicList,
+		},
+		Columns: []*plugin.Column{
+			{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromQual("repository_full_name"), Description: "Full name of the repository that contains the topic."},
+			{Name: "topic_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Topic.Name"), Description: "The name of the topic."},
+			{Name: "stargazer_count", Type: proto.ColumnType_INT, Transform: transform.FromField("Topic.StargazerCount"), Description: "Count of how many users have starred the topic."},
+			{Name: "url", Type: proto.ColumnType_STRING, Transform: transform.FromField("Url"), Description: "The URL of the topic in the GitHub UI."},
+			{Name: "node_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("NodeId"), Description: "The node ID of the repository topic."},
+		},
+	}
+}
+
+func tableGitHubRepositoryTopicList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	fullName := d.EqualsQuals["repository_full_name"].GetStringValue()
+	owner, repo := parseRepoFullName(fullName)
+
+	var query struct {
+		RateLimit  models.RateLimit
+		Repository struct {
+			RepositoryTopics struct {
+				TotalCount int
+				PageInfo   models.PageInfo
+				Nodes      []models.RepositoryTopic
+			} `graphql:"repositoryTopics(first: $pageSize, after: $cursor)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+
+	pageSize := adjustPageSize(100, d.QueryContext.Limit)
+	variables := map[string]interface{}{
+		"owner":    githubv4.String(owner),
+		"repo":     githubv4.String(repo),
+		"pageSize": githubv4.Int(pageSize),
+		"cursor":   (*githubv4.String)(nil),
+	}
+
+	client := connectV4(ctx, d)
+	for {
+		err := client.Query(ctx, &query, variables)
+		plugin.Logger(ctx).Debug(rateLimitLogString("github_repository_topic", &query.RateLimit))
+		if err != nil {
+			plugin.Logger(ctx).Error("github_repository_topic", "api_error", err)
+			return nil, err
+		}
+
+		for _, topic := range query.Repository.RepositoryTopics.Nodes {
+			d.StreamListItem(ctx, topic)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if !query.Repository.RepositoryTopics.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Repository.RepositoryTopics.PageInfo.EndCursor)
+	}
+
+	return nil, nil
+}
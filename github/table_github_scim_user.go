@@ -0,0 +1,122 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v55/github"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubScimUser() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_scim_user",
+		Description: "Identities provisioned by SCIM in the given organization.",
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "organization", Require: plugin.Required},
+				{Name: "user_name", Require: plugin.Optional},
+				{Name: "external_id", Require: plugin.Optional},
+			},
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubScimUserList,
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "organization", Type: proto.ColumnType_STRING, Transform: transform.FromQual("organization"), Description: "The organization the identity is provisioned in."},
+			{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ID"), Description: "The SCIM ID of the identity."},
+			{Name: "user_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("UserName"), Description: "The username configured by the identity provider, often an email address."},
+			{Name: "external_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ExternalID"), Description: "The ID of the user in the identity provider."},
+			{Name: "active", Type: proto.ColumnType_BOOL, Description: "If true, the identity is active in the identity provider."},
+			{Name: "primary_email", Type: proto.ColumnType_STRING, Transform: transform.FromField("Emails").Transform(scimUserPrimaryEmail), Description: "The primary email address of the identity."},
+			// Other columns
+			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "The name of the user, suitable for display."},
+			{Name: "given_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name.GivenName"), Description: "The first name of the user."},
+			{Name: "family_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name.FamilyName"), Description: "The family name of the user."},
+			{Name: "emails", Type: proto.ColumnType_JSON, Description: "The email addresses of the identity."},
+			{Name: "groups", Type: proto.ColumnType_JSON, Description: "The groups the identity belongs to in the identity provider."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Meta.Created").NullIfZero().Transform(convertTimestamp), Description: "Timestamp when the identity was provisioned."},
+			{Name: "last_modified_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Meta.LastModified").NullIfZero().Transform(convertTimestamp), Description: "Timestamp when the identity was last updated."},
+		},
+	}
+}
+
+func tableGitHubScimUserList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+
+	quals := d.EqualsQuals
+	org := quals["organization"].GetStringValue()
+
+	// The SCIM API only supports filtering on a single attribute
+	var filter string
+	if quals["user_name"] != nil {
+		filter = fmt.Sprintf("userName eq \"%s\"", escapeScimFilterValue(quals["user_name"].GetStringValue()))
+	} else if quals["external_id"] != nil {
+		filter = fmt.Sprintf("externalId eq \"%s\"", escapeScimFilterValue(quals["external_id"].GetStringValue()))
+	}
+
+	// The SCIM API uses 1-based start indexes instead of pages
+	startIndex := 1
+	count := 100
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit < int64(count) {
+			count = int(*limit)
+		}
+	}
+
+	opts := &github.ListSCIMProvisionedIdentitiesOptions{
+		StartIndex: &startIndex,
+		Count:      &count,
+	}
+	if filter != "" {
+		opts.Filter = &filter
+	}
+
+	for {
+		identities, _, err := client.SCIM.ListSCIMProvisionedIdentities(ctx, org, opts)
+		if err != nil {
+			plugin.Logger(ctx).Error("github_scim_user", "api_error", err)
+			return nil, err
+		}
+
+		for _, identity := range identities.Resources {
+			if identity != nil {
+				d.StreamListItem(ctx, identity)
+			}
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		startIndex += len(identities.Resources)
+		if len(identities.Resources) == 0 || startIndex > identities.GetTotalResults() {
+			break
+		}
+	}
+
+	return nil, nil
+}
+
+func escapeScimFilterValue(value string) string {
+	return strings.ReplaceAll(value, "\"", "\\\"")
+}
+
+func scimUserPrimaryEmail(_ context.Context, input *transform.TransformData) (interface{}, error) {
+	emails, ok := input.Value.([]*github.SCIMUserEmail)
+	if !ok {
+		return nil, nil
+	}
+	for _, email := range emails {
+		if email != nil && email.GetPrimary() {
+			return email.Value, nil
+		}
+	}
+	return nil, nil
+}
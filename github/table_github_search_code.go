@@ -2,7 +2,9 @@ package github
 
 import (
 	"context"
+	"path"
 	"regexp"
+	"strings"
 
 	"github.com/google/go-github/v55/github"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
@@ -15,8 +17,13 @@ func tableGitHubSearchCode() *plugin.Table {
 		Name:        "github_search_code",
 		Description: "Searches for query terms inside of a file.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("query"),
-			Hydrate:    tableGitHubSearchCodeList,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "query", Require: plugin.Required},
+				{Name: "repository_full_name", Require: plugin.Optional},
+				{Name: "path", Require: plugin.Optional},
+				{Name: "extension", Require: plugin.Optional},
+			},
+			Hydrate: tableGitHubSearchCodeList,
 		},
 		Columns: []*plugin.Column{
 			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the file where the match has been found."},
@@ -24,9 +31,11 @@ func tableGitHubSearchCode() *plugin.Table {
 			{Name: "html_url", Type: proto.ColumnType_STRING, Description: "The complete URL of the file where the match has been found."},
 			{Name: "sha", Type: proto.ColumnType_STRING, Transform: transform.FromField("SHA"), Description: "The SHA of the file where the match has been found."},
 			{Name: "path", Type: proto.ColumnType_STRING, Description: "The path of the file where the match has been found."},
+			{Name: "extension", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name").Transform(searchCodeExtension), Description: "The extension of the file where the match has been found, without the leading dot."},
 			{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.From(extractSearchCodeRepositoryFullName), Description: "The full name of the repository (login/repo-name)."},
 			{Name: "repository", Type: proto.ColumnType_JSON, Description: "The repository details of the file where the match has been found."},
 			{Name: "text_matches", Type: proto.ColumnType_JSON, Description: "The text match details."},
+			{Name: "fragments", Type: proto.ColumnType_JSON, Transform: transform.FromField("TextMatches").Transform(searchCodeFragments), Description: "The matched snippets of the file, each with the matched text and its start and end positions within the snippet."},
 		},
 	}
 }
@@ -42,6 +51,22 @@ func tableGitHubSearchCodeList(ctx context.Context, d *plugin.QueryData, h *plug
 		return nil, nil
 	}
 
+	// The quals are pushed down as search qualifiers, the code search has no
+	// exact path qualifier so a path is searched for by directory and file name
+	if quals["repository_full_name"] != nil {
+		query += " repo:" + quals["repository_full_name"].GetStringValue()
+	}
+	if quals["path"] != nil {
+		dir, file := path.Split(quals["path"].GetStringValue())
+		if dir != "" {
+			query += " path:" + dir
+		}
+		query += " filename:" + file
+	}
+	if quals["extension"] != nil {
+		query += " extension:" + quals["extension"].GetStringValue()
+	}
+
 	opt := &github.SearchOptions{
 		ListOptions: github.ListOptions{PerPage: 100},
 		TextMatch:   true,
@@ -92,3 +117,47 @@ func extractSearchCodeRepositoryFullName(_ context.Context, d *transform.Transfo
 	}
 	return "", nil
 }
+
+func searchCodeExtension(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	name, ok := d.Value.(*string)
+	if !ok || name == nil {
+		return nil, nil
+	}
+	return strings.TrimPrefix(path.Ext(*name), "."), nil
+}
+
+type searchCodeFragment struct {
+	Fragment string                    `json:"fragment"`
+	Matches  []searchCodeFragmentMatch `json:"matches"`
+}
+
+type searchCodeFragmentMatch struct {
+	Text  string `json:"text"`
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+}
+
+// searchCodeFragments flattens the text matches of the file contents to the
+// snippets with the positions of the matched text
+func searchCodeFragments(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	textMatches, ok := d.Value.([]*github.TextMatch)
+	if !ok {
+		return nil, nil
+	}
+
+	var fragments []searchCodeFragment
+	for _, tm := range textMatches {
+		if tm.GetProperty() != "content" {
+			continue
+		}
+		fragment := searchCodeFragment{Fragment: tm.GetFragment(), Matches: []searchCodeFragmentMatch{}}
+		for _, m := range tm.Matches {
+			if len(m.Indices) != 2 {
+				continue
+			}
+			fragment.Matches = append(fragment.Matches, searchCodeFragmentMatch{Text: m.GetText(), Start: m.Indices[0], End: m.Indices[1]})
+		}
+		fragments = append(fragments, fragment)
+	}
+	return fragments, nil
+}
@@ -0,0 +1,100 @@
+package github
+
+import (
+	"context"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/turbot/steampipe-plugin-github/github/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func gitHubSearchDiscussionColumns() []*plugin.Column {
+	return append(defaultSearchColumns(), []*plugin.Column{
+		{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Node.Repository.NameWithOwner"), Description: "The full name of the repository (login/repo-name)."},
+		{Name: "number", Type: proto.ColumnType_INT, Transform: transform.FromField("Node.Number"), Description: "The number of the discussion."},
+		{Name: "title", Type: proto.ColumnType_STRING, Transform: transform.FromField("Node.Title"), Description: "The title of the discussion."},
+		{Name: "author_login", Type: proto.ColumnType_STRING, Transform: transform.FromField("Node.Author.Login").NullIfZero(), Description: "The login of the discussion author."},
+		{Name: "category_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Node.Category.Name"), Description: "The name of the category of the discussion."},
+		{Name: "is_answered", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Node.IsAnswered"), Description: "If true, an answer has been chosen for the discussion."},
+		{Name: "closed", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Node.Closed"), Description: "If true, the discussion is closed."},
+		{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Node.CreatedAt").NullIfZero().Transform(convertTimestamp), Description: "Timestamp when the discussion was created."},
+		{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Node.UpdatedAt").NullIfZero().Transform(convertTimestamp), Description: "Timestamp when the discussion was last updated."},
+		{Name: "id", Type: proto.ColumnType_INT, Transform: transform.FromField("Node.Id"), Description: "The ID of the discussion."},
+		{Name: "node_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("Node.NodeId"), Description: "The node ID of the discussion."},
+		{Name: "body", Type: proto.ColumnType_STRING, Transform: transform.FromField("Node.Body"), Description: "The body of the discussion in Markdown."},
+		{Name: "url", Type: proto.ColumnType_STRING, Transform: transform.FromField("Node.Url"), Description: "The URL of the discussion."},
+		{Name: "closed_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Node.ClosedAt").NullIfZero().Transform(convertTimestamp), Description: "Timestamp when the discussion was closed."},
+		{Name: "locked", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Node.Locked"), Description: "If true, the discussion is locked."},
+		{Name: "answer_chosen_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Node.AnswerChosenAt").NullIfZero().Transform(convertTimestamp), Description: "Timestamp when the answer of the discussion was chosen."},
+		{Name: "category_is_answerable", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Node.Category.IsAnswerable"), Description: "If true, discussions in the category can be answered."},
+		{Name: "upvote_count", Type: proto.ColumnType_INT, Transform: transform.FromField("Node.UpvoteCount"), Description: "The number of upvotes of the discussion."},
+		{Name: "comments_total_count", Type: proto.ColumnType_INT, Transform: transform.FromField("Node.Comments.TotalCount"), Description: "The number of comments on the discussion."},
+	}...)
+}
+
+// Discussions can only be searched with the GraphQL API, which does not return a relevance score, so unlike the other
+// search tables there is no score column
+func tableGitHubSearchDiscussion() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_search_discussion",
+		Description: "Find discussions by keyword and qualifiers.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.SingleColumn("query"),
+			Hydrate:    tableGitHubSearchDiscussionList,
+		},
+		Columns: gitHubSearchDiscussionColumns(),
+	}
+}
+
+func tableGitHubSearchDiscussionList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	quals := d.EqualsQuals
+	input := quals["query"].GetStringValue()
+
+	if input == "" {
+		return nil, nil
+	}
+
+	var query struct {
+		RateLimit models.RateLimit
+		Search    struct {
+			PageInfo models.PageInfo
+			Edges    []models.SearchDiscussionResult
+		} `graphql:"search(type: DISCUSSION, first: $pageSize, after: $cursor, query: $query)"`
+	}
+
+	pageSize := adjustPageSize(100, d.QueryContext.Limit)
+	variables := map[string]interface{}{
+		"pageSize": githubv4.Int(pageSize),
+		"cursor":   (*githubv4.String)(nil),
+		"query":    githubv4.String(input),
+	}
+
+	client := connectV4(ctx, d)
+	for {
+		err := client.Query(ctx, &query, variables)
+		plugin.Logger(ctx).Debug(rateLimitLogString("github_search_discussion", &query.RateLimit))
+		if err != nil {
+			plugin.Logger(ctx).Error("github_search_discussion", "api_error", err)
+			return nil, err
+		}
+
+		for _, discussion := range query.Search.Edges {
+			d.StreamListItem(ctx, discussion)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if !query.Search.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Search.PageInfo.EndCursor)
+	}
+
+	return nil, nil
+}
@@ -2,13 +2,23 @@ package github
 
 import (
 	"context"
+	"fmt"
+	"slices"
+
 	"github.com/shurcooL/githubv4"
 	"github.com/turbot/steampipe-plugin-github/github/models"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 )
 
 func gitHubSearchIssueColumns() []*plugin.Column {
-	return append(defaultSearchColumns(), gitHubMyIssueColumns()...)
+	issueSearchCols := []*plugin.Column{
+		{Name: "sort", Type: proto.ColumnType_STRING, Transform: transform.FromQual("sort"), Description: "The field the results are sorted by, one of comments, created, updated, reactions or interactions. Without it the results are sorted by best match."},
+		{Name: "sort_order", Type: proto.ColumnType_STRING, Transform: transform.FromQual("sort_order"), Description: "The order of the sorted results, either asc or desc. Defaults to desc."},
+		{Name: "score", Type: proto.ColumnType_DOUBLE, Description: "The relevance score of the issue for the query, as returned by the REST search API."},
+	}
+	return append(append(defaultSearchColumns(), issueSearchCols...), gitHubMyIssueColumns()...)
 }
 
 func tableGitHubSearchIssue() *plugin.Table {
@@ -16,13 +26,23 @@ func tableGitHubSearchIssue() *plugin.Table {
 		Name:        "github_search_issue",
 		Description: "Find issues by state and keyword.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("query"),
-			Hydrate:    tableGitHubSearchIssueList,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "query", Require: plugin.Required},
+				{Name: "sort", Require: plugin.Optional},
+				{Name: "sort_order", Require: plugin.Optional},
+			},
+			Hydrate: tableGitHubSearchIssueList,
 		},
 		Columns: gitHubSearchIssueColumns(),
 	}
 }
 
+// searchIssueRow is an issue search result with its relevance score
+type searchIssueRow struct {
+	models.SearchIssueResult
+	Score *float64
+}
+
 func tableGitHubSearchIssueList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	quals := d.EqualsQuals
 	input := quals["query"].GetStringValue()
@@ -33,6 +53,15 @@ func tableGitHubSearchIssueList(ctx context.Context, d *plugin.QueryData, h *plu
 
 	input += " is:issue"
 
+	sort, order, err := searchIssueSortAndOrder(d)
+	if err != nil {
+		plugin.Logger(ctx).Error("github_search_issue", "invalid filter", err)
+		return nil, err
+	}
+	if sort != "" {
+		input += fmt.Sprintf(" sort:%s-%s", sort, order)
+	}
+
 	var query struct {
 		RateLimit models.RateLimit
 		Search    struct {
@@ -49,7 +78,15 @@ func tableGitHubSearchIssueList(ctx context.Context, d *plugin.QueryData, h *plu
 	}
 	appendIssueColumnIncludes(&variables, d.QueryContext.Columns)
 
+	// The GraphQL search does not return the relevance score, so the scores are
+	// fetched from the REST search API only if required
+	var scores *searchScores
+	if slices.Contains(d.QueryContext.Columns, "score") {
+		scores = newSearchScores(connect(ctx, d), "search/issues", input, pageSize)
+	}
+
 	client := connectV4(ctx, d)
+	position := 0
 	for {
 		err := client.Query(ctx, &query, variables)
 		plugin.Logger(ctx).Debug(rateLimitLogString("github_search_issue", &query.RateLimit))
@@ -59,7 +96,16 @@ func tableGitHubSearchIssueList(ctx context.Context, d *plugin.QueryData, h *plu
 		}
 
 		for _, issue := range query.Search.Edges {
-			d.StreamListItem(ctx, issue)
+			row := searchIssueRow{SearchIssueResult: issue}
+			if scores != nil {
+				row.Score, err = scores.get(ctx, issue.Node.NodeId, position)
+				if err != nil {
+					plugin.Logger(ctx).Error("github_search_issue", "api_error", err)
+					return nil, err
+				}
+			}
+			position++
+			d.StreamListItem(ctx, row)
 
 			// Context can be cancelled due to manual cancellation or the limit has been hit
 			if d.RowsRemaining(ctx) == 0 {
@@ -75,3 +121,31 @@ func tableGitHubSearchIssueList(ctx context.Context, d *plugin.QueryData, h *plu
 
 	return nil, nil
 }
+
+// searchIssueSortAndOrder returns the validated sort and order quals, the
+// order defaults to descending
+func searchIssueSortAndOrder(d *plugin.QueryData) (string, string, error) {
+	quals := d.EqualsQuals
+
+	sort := ""
+	if quals["sort"] != nil {
+		sort = quals["sort"].GetStringValue()
+		switch sort {
+		case "comments", "created", "updated", "reactions", "interactions":
+		default:
+			return "", "", fmt.Errorf("invalid value for 'sort' can only filter for 'comments', 'created', 'updated', 'reactions' or 'interactions' - you attempted to filter for '%s'", sort)
+		}
+	}
+
+	order := "desc"
+	if quals["sort_order"] != nil {
+		order = quals["sort_order"].GetStringValue()
+		switch order {
+		case "asc", "desc":
+		default:
+			return "", "", fmt.Errorf("invalid value for 'sort_order' can only filter for 'asc' or 'desc' - you attempted to filter for '%s'", order)
+		}
+	}
+
+	return sort, order, nil
+}
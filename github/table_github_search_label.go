@@ -11,18 +11,27 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 )
 
+type searchLabelRow struct {
+	*github.LabelResult
+	RepositoryID int64
+}
+
 func tableGitHubSearchLabel() *plugin.Table {
 	return &plugin.Table{
 		Name:        "github_search_label",
 		Description: "Find labels in a repository with names or descriptions that match search keywords.",
 		List: &plugin.ListConfig{
-			KeyColumns:        plugin.AllColumns([]string{"query", "repository_id"}),
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "query", Require: plugin.Required},
+				{Name: "repository_id", Require: plugin.AnyOf},
+				{Name: "repository_full_name", Require: plugin.AnyOf},
+			},
 			ShouldIgnoreError: isNotFoundError([]string{"404"}),
 			Hydrate:           tableGitHubSearchLabelList,
 		},
 		Columns: []*plugin.Column{
 			{Name: "id", Transform: transform.FromField("ID"), Type: proto.ColumnType_INT, Description: "The ID of the label."},
-			{Name: "repository_id", Type: proto.ColumnType_INT, Transform: transform.FromQual("repository_id"), Description: "The ID of the repository."},
+			{Name: "repository_id", Type: proto.ColumnType_INT, Transform: transform.FromField("RepositoryID"), Description: "The ID of the repository."},
 			{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.From(extractSearchLabelRepositoryFullName), Description: "The full name of the repository (login/repo-name)."},
 			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the label."},
 			{Name: "query", Type: proto.ColumnType_STRING, Transform: transform.FromQual("query"), Description: "The query used to match the label."},
@@ -48,6 +57,21 @@ func tableGitHubSearchLabelList(ctx context.Context, d *plugin.QueryData, h *plu
 		return nil, nil
 	}
 
+	client := connect(ctx, d)
+
+	// The search API requires the repository ID, which is looked up if only
+	// the full name of the repository is provided
+	if repoId == 0 {
+		fullName := quals["repository_full_name"].GetStringValue()
+		owner, repo := parseRepoFullName(fullName)
+		repository, err := getRepositoryV3(ctx, d, owner, repo)
+		if err != nil {
+			logger.Error("tableGitHubSearchLabelList", "api_error", err)
+			return nil, err
+		}
+		repoId = repository.GetID()
+	}
+
 	opt := &github.SearchOptions{
 		ListOptions: github.ListOptions{PerPage: 100},
 		TextMatch:   true,
@@ -61,7 +85,6 @@ func tableGitHubSearchLabelList(ctx context.Context, d *plugin.QueryData, h *plu
 		}
 	}
 
-	client := connect(ctx, d)
 	for {
 		result, resp, err := client.Search.Labels(ctx, repoId, query, opt)
 		if err != nil {
@@ -71,7 +94,7 @@ func tableGitHubSearchLabelList(ctx context.Context, d *plugin.QueryData, h *plu
 
 		labels := result.Labels
 		for _, i := range labels {
-			d.StreamListItem(ctx, i)
+			d.StreamListItem(ctx, searchLabelRow{LabelResult: i, RepositoryID: repoId})
 
 			// Context can be cancelled due to manual cancellation or the limit has been hit
 			if d.RowsRemaining(ctx) == 0 {
@@ -90,7 +113,7 @@ func tableGitHubSearchLabelList(ctx context.Context, d *plugin.QueryData, h *plu
 }
 
 func extractSearchLabelRepositoryFullName(_ context.Context, d *transform.TransformData) (interface{}, error) {
-	label := d.HydrateItem.(*github.LabelResult)
+	label := d.HydrateItem.(searchLabelRow)
 	if label.URL != nil {
 		rx := regexp.MustCompile(`(?s)` + regexp.QuoteMeta("repos/") + `(.*?)` + regexp.QuoteMeta("/labels"))
 		replacer := strings.NewReplacer("repos/", "", "/labels", "")
@@ -2,6 +2,8 @@ package github
 
 import (
 	"context"
+	"fmt"
+	"net/url"
 
 	"github.com/google/go-github/v55/github"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
@@ -9,6 +11,22 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 )
 
+// searchTopicResult extends the go-github topic result with the fields it does not decode
+type searchTopicResult struct {
+	github.TopicResult
+	RepositoryCount *int          `json:"repository_count,omitempty"`
+	Released        *string       `json:"released,omitempty"`
+	LogoURL         *string       `json:"logo_url,omitempty"`
+	Aliases         []interface{} `json:"aliases,omitempty"`
+	Related         []interface{} `json:"related,omitempty"`
+}
+
+type searchTopicsResult struct {
+	Total             *int                 `json:"total_count,omitempty"`
+	IncompleteResults *bool                `json:"incomplete_results,omitempty"`
+	Topics            []*searchTopicResult `json:"items,omitempty"`
+}
+
 func tableGitHubSearchTopic() *plugin.Table {
 	return &plugin.Table{
 		Name:        "github_search_topic",
@@ -29,6 +47,11 @@ func tableGitHubSearchTopic() *plugin.Table {
 			{Name: "score", Type: proto.ColumnType_DOUBLE, Description: "The score of the topic."},
 			{Name: "short_description", Type: proto.ColumnType_STRING, Description: "The short description of the topic."},
 			{Name: "updated_at", Type: proto.ColumnType_TIMESTAMP, Description: "The timestamp when the topic was updated."},
+			{Name: "repository_count", Type: proto.ColumnType_INT, Description: "The number of public repositories tagged with the topic."},
+			{Name: "released", Type: proto.ColumnType_STRING, Description: "When the project the topic is about was first released, as provided by GitHub for curated topics."},
+			{Name: "logo_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("LogoURL"), Description: "The URL of the logo of the topic."},
+			{Name: "aliases", Type: proto.ColumnType_JSON, Description: "Other names of the topic that redirect to it."},
+			{Name: "related", Type: proto.ColumnType_JSON, Description: "Topics related to the topic."},
 		},
 	}
 }
@@ -46,7 +69,6 @@ func tableGitHubSearchTopicList(ctx context.Context, d *plugin.QueryData, h *plu
 
 	opt := &github.SearchOptions{
 		ListOptions: github.ListOptions{PerPage: 100},
-		TextMatch:   true,
 	}
 
 	client := connect(ctx, d)
@@ -60,7 +82,16 @@ func tableGitHubSearchTopicList(ctx context.Context, d *plugin.QueryData, h *plu
 	}
 
 	for {
-		result, resp, err := client.Search.Topics(ctx, query, opt)
+		// The go-github client does not decode the repository count and the
+		// related topics, so the search is made with a raw request
+		req, err := client.NewRequest("GET", fmt.Sprintf("search/topics?q=%s&per_page=%v&page=%v", url.QueryEscape(query), opt.ListOptions.PerPage, opt.Page), nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Accept", "application/vnd.github.mercy-preview+json")
+
+		result := &searchTopicsResult{}
+		resp, err := client.Do(ctx, req, result)
 		if err != nil {
 			logger.Error("tableGitHubSearchTopicList", "error_RetryHydrate", err)
 			return nil, err
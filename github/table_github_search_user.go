@@ -2,6 +2,8 @@ package github
 
 import (
 	"context"
+	"slices"
+
 	"github.com/shurcooL/githubv4"
 	"github.com/turbot/steampipe-plugin-github/github/models"
 
@@ -42,6 +44,7 @@ func gitHubSearchUserColumns() []*plugin.Column {
 		{Name: "is_site_admin", Type: proto.ColumnType_BOOL, Description: "If true, user is a site administrator."},
 		{Name: "is_you", Type: proto.ColumnType_BOOL, Description: "If true, user is you."},
 		{Name: "website_url", Type: proto.ColumnType_STRING, Description: "The URL pointing to the user/organization's public website/blog.", Transform: transform.FromField("WebsiteUrl")},
+		{Name: "score", Type: proto.ColumnType_DOUBLE, Description: "The relevance score of the user/organization for the query, as returned by the REST search API."},
 	}
 	return append(defaultSearchColumns(), userSearchCols...)
 }
@@ -85,7 +88,15 @@ func tableGitHubSearchUserList(ctx context.Context, d *plugin.QueryData, h *plug
 		"query":    githubv4.String(input),
 	}
 
+	// The GraphQL search does not return the relevance score, so the scores are
+	// fetched from the REST search API only if required
+	var scores *searchScores
+	if slices.Contains(d.QueryContext.Columns, "score") {
+		scores = newSearchScores(connect(ctx, d), "search/users", input, pageSize)
+	}
+
 	client := connectV4(ctx, d)
+	position := 0
 	for {
 		err := client.Query(ctx, &query, variables)
 		plugin.Logger(ctx).Debug(rateLimitLogString("github_search_user", &query.RateLimit))
@@ -95,7 +106,16 @@ func tableGitHubSearchUserList(ctx context.Context, d *plugin.QueryData, h *plug
 		}
 
 		for _, item := range query.Search.Edges {
-			d.StreamListItem(ctx, mapToUserSearchRow(&item.Node, &item.TextMatches))
+			row := mapToUserSearchRow(&item.Node, &item.TextMatches)
+			if scores != nil {
+				row.Score, err = scores.get(ctx, row.NodeId, position)
+				if err != nil {
+					plugin.Logger(ctx).Error("github_search_user", "api_error", err)
+					return nil, err
+				}
+			}
+			position++
+			d.StreamListItem(ctx, row)
 
 			// Context can be cancelled due to manual cancellation or the limit has been hit
 			if d.RowsRemaining(ctx) == 0 {
@@ -179,27 +199,28 @@ type userSearchNode struct {
 
 type userSearchRow struct {
 	models.BasicUser
-	Type                     string `json:"type"`
-	AvatarUrl                string `json:"avatar_url"`
-	Bio                      string `json:"bio"`
-	Description              string `json:"description"`
-	Company                  string `json:"company"`
-	Location                 string `json:"location"`
-	TwitterUsername          string `json:"twitter_username"`
-	ProjectsUrl              string `json:"projects_url"`
-	CanFollow                bool   `json:"can_follow"`
-	CanSponsor               bool   `json:"can_sponsor"`
-	IsFollowing              bool   `json:"is_following"`
-	IsSponsoring             bool   `json:"is_sponsoring"`
-	IsBountyHunter           bool   `json:"is_bounty_hunter"`
-	IsCampusExpert           bool   `json:"is_campus_expert"`
-	IsDeveloperProgramMember bool   `json:"is_developer_program_member"`
-	IsYou                    bool   `json:"is_you"`
-	IsEmployee               bool   `json:"is_employee"`
-	IsFollowingYou           bool   `json:"is_following_you"`
-	IsGitHubStar             bool   `json:"is_github_star"`
-	IsHireable               bool   `json:"is_hireable"`
-	IsSiteAdmin              bool   `json:"is_site_admin"`
-	WebsiteUrl               string `json:"website_url"`
+	Type                     string   `json:"type"`
+	AvatarUrl                string   `json:"avatar_url"`
+	Bio                      string   `json:"bio"`
+	Description              string   `json:"description"`
+	Company                  string   `json:"company"`
+	Location                 string   `json:"location"`
+	TwitterUsername          string   `json:"twitter_username"`
+	ProjectsUrl              string   `json:"projects_url"`
+	CanFollow                bool     `json:"can_follow"`
+	CanSponsor               bool     `json:"can_sponsor"`
+	IsFollowing              bool     `json:"is_following"`
+	IsSponsoring             bool     `json:"is_sponsoring"`
+	IsBountyHunter           bool     `json:"is_bounty_hunter"`
+	IsCampusExpert           bool     `json:"is_campus_expert"`
+	IsDeveloperProgramMember bool     `json:"is_developer_program_member"`
+	IsYou                    bool     `json:"is_you"`
+	IsEmployee               bool     `json:"is_employee"`
+	IsFollowingYou           bool     `json:"is_following_you"`
+	IsGitHubStar             bool     `json:"is_github_star"`
+	IsHireable               bool     `json:"is_hireable"`
+	IsSiteAdmin              bool     `json:"is_site_admin"`
+	WebsiteUrl               string   `json:"website_url"`
+	Score                    *float64 `json:"score"`
 	TextMatches              []models.TextMatch
 }
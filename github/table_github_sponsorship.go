@@ -0,0 +1,162 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/turbot/steampipe-plugin-github/github/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type sponsorshipConnection struct {
+	PageInfo models.PageInfo
+	Nodes    []models.Sponsorship
+}
+
+type sponsorship struct {
+	models.Sponsorship
+	Direction string
+}
+
+func tableGitHubSponsorship() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_sponsorship",
+		Description: "GitHub Sponsors sponsorships of the given user or organization, either as the maintainer being sponsored or as the sponsor.",
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "login", Require: plugin.Required},
+				{Name: "direction", Require: plugin.Optional},
+			},
+			Hydrate: tableGitHubSponsorshipList,
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "login", Type: proto.ColumnType_STRING, Transform: transform.FromQual("login"), Description: "The login of the user or organization the sponsorships are listed for."},
+			{Name: "direction", Type: proto.ColumnType_STRING, Description: "Either maintainer, for sponsorships the account receives, or sponsor, for sponsorships the account pays for."},
+			{Name: "sponsor_login", Type: proto.ColumnType_STRING, Transform: transform.FromField("SponsorEntity.Actor.Login").NullIfZero(), Description: "The login of the sponsor."},
+			{Name: "maintainer_login", Type: proto.ColumnType_STRING, Transform: transform.FromField("Sponsorable.Actor.Login").NullIfZero(), Description: "The login of the user or organization being sponsored."},
+			{Name: "tier_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Tier.Name").NullIfZero(), Description: "The name of the sponsorship tier."},
+			{Name: "monthly_price_in_dollars", Type: proto.ColumnType_INT, Transform: transform.FromField("Tier.MonthlyPriceInDollars"), Description: "The monthly price of the tier in US dollars, or the amount of a one-time payment."},
+			{Name: "privacy_level", Type: proto.ColumnType_STRING, Description: "The privacy level of the sponsorship, either PUBLIC or PRIVATE."},
+			{Name: "is_one_time_payment", Type: proto.ColumnType_BOOL, Description: "If true, the sponsorship is a one-time payment rather than recurring."},
+			{Name: "is_active", Type: proto.ColumnType_BOOL, Description: "If true, the sponsorship is currently active."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("CreatedAt").NullIfZero().Transform(convertTimestamp), Description: "Timestamp when the sponsorship was created."},
+			// Other columns
+			{Name: "node_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("NodeId"), Description: "The node ID of the sponsorship."},
+			{Name: "tier_is_custom_amount", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Tier.IsCustomAmount"), Description: "If true, the sponsor chose a custom amount rather than a published tier."},
+			{Name: "tier_selected_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("TierSelectedAt").NullIfZero().Transform(convertTimestamp), Description: "Timestamp when the current tier was selected."},
+		},
+	}
+}
+
+func tableGitHubSponsorshipList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	quals := d.EqualsQuals
+	login := quals["login"].GetStringValue()
+
+	directions := []string{"maintainer", "sponsor"}
+	if quals["direction"] != nil {
+		directions = []string{quals["direction"].GetStringValue()}
+	}
+
+	pageSize := adjustPageSize(100, d.QueryContext.Limit)
+
+	for _, direction := range directions {
+		variables := map[string]interface{}{
+			"login":    githubv4.String(login),
+			"pageSize": githubv4.Int(pageSize),
+			"cursor":   (*githubv4.String)(nil),
+		}
+
+		for {
+			conn, err := querySponsorships(ctx, d, direction, variables)
+			if err != nil {
+				plugin.Logger(ctx).Error("github_sponsorship", "api_error", err)
+				return nil, err
+			}
+			if conn == nil {
+				break
+			}
+
+			for _, s := range conn.Nodes {
+				d.StreamListItem(ctx, sponsorship{Sponsorship: s, Direction: direction})
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+
+			if !conn.PageInfo.HasNextPage {
+				break
+			}
+			variables["cursor"] = githubv4.NewString(conn.PageInfo.EndCursor)
+		}
+	}
+
+	return nil, nil
+}
+
+// querySponsorships fetches a page of the sponsorships in the given direction of either a user or an organization
+func querySponsorships(ctx context.Context, d *plugin.QueryData, direction string, variables map[string]interface{}) (*sponsorshipConnection, error) {
+	client := connectV4(ctx, d)
+
+	switch direction {
+	case "maintainer":
+		var query struct {
+			RateLimit       models.RateLimit
+			RepositoryOwner *struct {
+				User struct {
+					Sponsorships sponsorshipConnection `graphql:"sponsorshipsAsMaintainer(first: $pageSize, after: $cursor, includePrivate: true, activeOnly: false)"`
+				} `graphql:"... on User"`
+				Organization struct {
+					Sponsorships sponsorshipConnection `graphql:"sponsorshipsAsMaintainer(first: $pageSize, after: $cursor, includePrivate: true, activeOnly: false)"`
+				} `graphql:"... on Organization"`
+			} `graphql:"repositoryOwner(login: $login)"`
+		}
+		err := client.Query(ctx, &query, variables)
+		plugin.Logger(ctx).Debug(rateLimitLogString("github_sponsorship", &query.RateLimit))
+		if err != nil {
+			return nil, err
+		}
+		if query.RepositoryOwner == nil {
+			return nil, fmt.Errorf("could not resolve to a user or organization with the login of '%s'", variables["login"])
+		}
+		return mergeSponsorshipConnections(query.RepositoryOwner.User.Sponsorships, query.RepositoryOwner.Organization.Sponsorships), nil
+	case "sponsor":
+		var query struct {
+			RateLimit       models.RateLimit
+			RepositoryOwner *struct {
+				User struct {
+					Sponsorships sponsorshipConnection `graphql:"sponsorshipsAsSponsor(first: $pageSize, after: $cursor, activeOnly: false)"`
+				} `graphql:"... on User"`
+				Organization struct {
+					Sponsorships sponsorshipConnection `graphql:"sponsorshipsAsSponsor(first: $pageSize, after: $cursor, activeOnly: false)"`
+				} `graphql:"... on Organization"`
+			} `graphql:"repositoryOwner(login: $login)"`
+		}
+		err := client.Query(ctx, &query, variables)
+		plugin.Logger(ctx).Debug(rateLimitLogString("github_sponsorship", &query.RateLimit))
+		if err != nil {
+			return nil, err
+		}
+		if query.RepositoryOwner == nil {
+			return nil, fmt.Errorf("could not resolve to a user or organization with the login of '%s'", variables["login"])
+		}
+		return mergeSponsorshipConnections(query.RepositoryOwner.User.Sponsorships, query.RepositoryOwner.Organization.Sponsorships), nil
+	}
+
+	return nil, nil
+}
+
+// mergeSponsorshipConnections combines the connections of the user and organization fragments, only one of which is
+// populated depending on the type of the account
+func mergeSponsorshipConnections(user sponsorshipConnection, org sponsorshipConnection) *sponsorshipConnection {
+	if user.PageInfo.HasNextPage || len(user.Nodes) > 0 {
+		return &user
+	}
+	return &org
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/shurcooL/githubv4"
 	"github.com/turbot/steampipe-plugin-github/github/models"
+	"slices"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
@@ -24,6 +25,10 @@ func tableGitHubStargazer() *plugin.Table {
 			{Name: "starred_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("StarredAt").Transform(convertTimestamp), Description: "Time when the stargazer was created."},
 			{Name: "user_login", Type: proto.ColumnType_STRING, Transform: transform.FromField("Node.Login"), Description: "The login name of the user who starred the repository."},
 			{Name: "user_detail", Type: proto.ColumnType_JSON, Transform: transform.FromField("Node"), Description: "Details of the user who starred the repository."},
+			{Name: "user_created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Node.CreatedAt").NullIfZero().Transform(convertTimestamp), Description: "Time when the account of the user who starred the repository was created."},
+			{Name: "user_company", Type: proto.ColumnType_STRING, Transform: transform.FromField("Node.Company").NullIfZero(), Description: "The company of the user who starred the repository, from their public profile."},
+			{Name: "user_location", Type: proto.ColumnType_STRING, Transform: transform.FromField("Node.Location").NullIfZero(), Description: "The location of the user who starred the repository, from their public profile."},
+			{Name: "user_followers_total_count", Type: proto.ColumnType_INT, Transform: transform.FromField("Node.Followers.TotalCount"), Description: "The number of followers of the user who starred the repository."},
 		},
 	}
 }
@@ -40,7 +45,7 @@ func tableGitHubStargazerList(ctx context.Context, d *plugin.QueryData, h *plugi
 				PageInfo   models.PageInfo
 				Edges      []struct {
 					StarredAt models.NullableTime
-					Node      models.BasicUser
+					Node      models.UserProfile
 				}
 			} `graphql:"stargazers(first: $pageSize, after: $cursor)"`
 		} `graphql:"repository(owner: $owner, name: $repo)"`
@@ -54,6 +59,13 @@ func tableGitHubStargazerList(ctx context.Context, d *plugin.QueryData, h *plugi
 		"cursor":   (*githubv4.String)(nil),
 	}
 
+	// The profile of each stargazer is fetched in the same query, but only if required
+	cols := d.QueryContext.Columns
+	variables["includeUserProfile"] = githubv4.Boolean(slices.Contains(cols, "user_detail") ||
+		slices.Contains(cols, "user_company") ||
+		slices.Contains(cols, "user_location") ||
+		slices.Contains(cols, "user_followers_total_count"))
+
 	client := connectV4(ctx, d)
 	for {
 		err := client.Query(ctx, &query, variables)
@@ -2,9 +2,11 @@ package github
 
 import (
 	"context"
+	"slices"
+	"time"
+
 	"github.com/shurcooL/githubv4"
 	"github.com/turbot/steampipe-plugin-github/github/models"
-	"time"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
@@ -26,8 +28,15 @@ func tableGitHubTag() *plugin.Table {
 			{Name: "tagger_date", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("TaggerDate").NullIfZero(), Description: "Date the tag was created."},
 			{Name: "tagger_name", Type: proto.ColumnType_STRING, Description: "Name of user whom created the tag."},
 			{Name: "tagger_login", Type: proto.ColumnType_STRING, Description: "Login of user whom created the tag."},
+			{Name: "tagger_email", Type: proto.ColumnType_STRING, Description: "Email of user whom created the tag."},
 			{Name: "message", Type: proto.ColumnType_STRING, Description: "Message associated with the tag."},
+			{Name: "is_annotated", Type: proto.ColumnType_BOOL, Description: "If true, the tag is an annotated tag with its own tagger and message, rather than a lightweight tag pointing directly at a commit."},
+			{Name: "tag_sha", Type: proto.ColumnType_STRING, Transform: transform.FromField("TagSha").NullIfZero(), Description: "SHA of the tag object, for annotated tags."},
+			{Name: "commit_sha", Type: proto.ColumnType_STRING, Transform: transform.FromField("Commit.Sha"), Description: "SHA of the commit the tag is associated with."},
 			{Name: "commit", Type: proto.ColumnType_JSON, Description: "Commit the tag is associated with."},
+			{Name: "release_id", Type: proto.ColumnType_INT, Transform: transform.FromField("Release.Id"), Description: "ID of the release created from the tag, if any."},
+			{Name: "release_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Release.Name"), Description: "Name of the release created from the tag, if any."},
+			{Name: "release", Type: proto.ColumnType_JSON, Description: "The release created from the tag, if any."},
 		},
 	}
 }
@@ -54,8 +63,22 @@ func tableGitHubTagList(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 		"pageSize": githubv4.Int(pageSize),
 		"cursor":   (*githubv4.String)(nil),
 	}
+	appendCommitColumnIncludes(&variables, d.QueryContext.Columns)
 
 	client := connectV4(ctx, d)
+
+	// Refs have no link to the releases created from them, so the releases
+	// are listed separately and matched by tag name
+	var releases map[string]*models.TagRelease
+	if slices.Contains(d.QueryContext.Columns, "release_id") || slices.Contains(d.QueryContext.Columns, "release_name") || slices.Contains(d.QueryContext.Columns, "release") {
+		var err error
+		releases, err = listTagReleases(ctx, client, owner, repo)
+		if err != nil {
+			plugin.Logger(ctx).Error("github_tag", "api_error", err)
+			return nil, err
+		}
+	}
+
 	for {
 		err := client.Query(ctx, &query, variables)
 		plugin.Logger(ctx).Debug(rateLimitLogString("github_tag", &query.RateLimit))
@@ -65,7 +88,9 @@ func tableGitHubTagList(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 		}
 
 		for _, tag := range query.Repository.Refs.Nodes {
-			d.StreamListItem(ctx, mapTagRow(&tag))
+			row := mapTagRow(&tag)
+			row.Release = releases[tag.Name]
+			d.StreamListItem(ctx, row)
 
 			// Context can be cancelled due to manual cancellation or the limit has been hit
 			if d.RowsRemaining(ctx) == 0 {
@@ -88,9 +113,13 @@ type tagRow struct {
 	Name        string
 	TaggerDate  time.Time
 	TaggerName  string
+	TaggerEmail string
 	TaggerLogin string
 	Message     string
+	IsAnnotated bool
+	TagSha      string
 	Commit      models.Commit
+	Release     *models.TagRelease
 }
 
 // mapTagRow is required as commit information may reside at upper target level or embedded into the tags target level.
@@ -99,8 +128,11 @@ func mapTagRow(tag *models.TagWithCommits) tagRow {
 		Name:        tag.Name,
 		TaggerName:  tag.Target.Tag.Tagger.Name,
 		TaggerDate:  tag.Target.Tag.Tagger.Date,
+		TaggerEmail: tag.Target.Tag.Tagger.Email,
 		TaggerLogin: tag.Target.Tag.Tagger.User.Login,
 		Message:     tag.Target.Tag.Message,
+		IsAnnotated: tag.Target.Type == "Tag",
+		TagSha:      tag.Target.Tag.Sha,
 	}
 
 	if tag.Target.Commit.Sha != "" {
@@ -111,3 +143,44 @@ func mapTagRow(tag *models.TagWithCommits) tagRow {
 
 	return row
 }
+
+// listTagReleases returns the releases of the repository keyed by tag name
+func listTagReleases(ctx context.Context, client *githubv4.Client, owner string, repo string) (map[string]*models.TagRelease, error) {
+	var query struct {
+		RateLimit  models.RateLimit
+		Repository struct {
+			Releases struct {
+				PageInfo models.PageInfo
+				Nodes    []models.TagRelease
+			} `graphql:"releases(first: $pageSize, after: $cursor)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner":    githubv4.String(owner),
+		"repo":     githubv4.String(repo),
+		"pageSize": githubv4.Int(100),
+		"cursor":   (*githubv4.String)(nil),
+	}
+
+	releases := map[string]*models.TagRelease{}
+	for {
+		err := client.Query(ctx, &query, variables)
+		plugin.Logger(ctx).Debug(rateLimitLogString("github_tag", &query.RateLimit))
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range query.Repository.Releases.Nodes {
+			release := query.Repository.Releases.Nodes[i]
+			releases[release.TagName] = &release
+		}
+
+		if !query.Repository.Releases.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Repository.Releases.PageInfo.EndCursor)
+	}
+
+	return releases, nil
+}
@@ -0,0 +1,53 @@
+package github
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubTagProtection() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_tag_protection",
+		Description: "Tag protection patterns configured on the given repository.",
+		List: &plugin.ListConfig{
+			Hydrate:           tableGitHubTagProtectionList,
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			KeyColumns:        plugin.SingleColumn("repository_full_name"),
+		},
+		Columns: []*plugin.Column{
+			{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromQual("repository_full_name"), Description: "Full name of the repository that contains the tag protection."},
+			{Name: "id", Type: proto.ColumnType_INT, Transform: transform.FromField("ID"), Description: "The ID of the tag protection."},
+			{Name: "pattern", Type: proto.ColumnType_STRING, Description: "The pattern of the tags that are protected, for example v*."},
+		},
+	}
+}
+
+func tableGitHubTagProtectionList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+
+	fullName := d.EqualsQuals["repository_full_name"].GetStringValue()
+	owner, repo := parseRepoFullName(fullName)
+
+	// The response is not paginated, all of the tag protections are returned at once
+	protections, _, err := client.Repositories.ListTagProtection(ctx, owner, repo)
+	if err != nil {
+		plugin.Logger(ctx).Error("github_tag_protection", "api_error", err)
+		return nil, err
+	}
+
+	for _, p := range protections {
+		if p != nil {
+			d.StreamListItem(ctx, p)
+		}
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
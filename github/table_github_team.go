@@ -31,6 +31,10 @@ func gitHubTeamColumns() []*plugin.Column {
 		{Name: "members_total_count", Type: proto.ColumnType_INT, Description: "Count of team members.", Transform: transform.FromField("Members.TotalCount")},
 		{Name: "projects_v2_total_count", Type: proto.ColumnType_INT, Description: "Count of the teams v2 projects.", Transform: transform.FromField("ProjectsV2.TotalCount")},
 		{Name: "repositories_total_count", Type: proto.ColumnType_INT, Description: "Count of repositories the team has.", Transform: transform.FromField("Repositories.TotalCount")},
+		{Name: "ancestor_team_slugs", Type: proto.ColumnType_JSON, Description: "Slugs of the first 100 ancestor teams of this team, i.e. its parent team, the parent of that team and so on.", Transform: transform.FromField("AncestorTeams.Nodes").Transform(teamSlugs)},
+		{Name: "child_team_slugs", Type: proto.ColumnType_JSON, Description: "Slugs of the first 100 immediate child teams of this team.", Transform: transform.FromField("ImmediateChildTeams.Nodes").Transform(teamSlugs)},
+		{Name: "descendant_team_slugs", Type: proto.ColumnType_JSON, Description: "Slugs of the first 100 descendant teams of this team, i.e. its child teams, their child teams and so on.", Transform: transform.FromField("DescendantTeams.Nodes").Transform(teamSlugs)},
+		{Name: "idp_groups", Type: proto.ColumnType_JSON, Description: "Identity provider groups mapped to the team with team synchronization, null if team synchronization is not enabled for the organization.", Hydrate: teamHydrateIdpGroups, Transform: transform.FromValue()},
 		{Name: "url", Type: proto.ColumnType_STRING, Description: "URL for the team page in GitHub.", Transform: transform.FromField("Url")},
 		{Name: "avatar_url", Type: proto.ColumnType_STRING, Description: "URL for teams avatar.", Transform: transform.FromField("AvatarUrl")},
 		{Name: "discussions_url", Type: proto.ColumnType_STRING, Description: "URL for team discussions.", Transform: transform.FromField("DiscussionsUrl")},
@@ -85,6 +89,7 @@ func tableGitHubTeamList(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 		"pageSize": githubv4.Int(pageSize),
 		"cursor":   (*githubv4.String)(nil),
 	}
+	appendTeamColumnIncludes(&variables, d.QueryContext.Columns)
 
 	client := connectV4(ctx, d)
 	for {
@@ -132,6 +137,7 @@ func tableGitHubTeamGet(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 		"login": githubv4.String(org),
 		"slug":  githubv4.String(slug),
 	}
+	appendTeamColumnIncludes(&variables, d.QueryContext.Columns)
 
 	client := connectV4(ctx, d)
 	err := client.Query(ctx, &query, variables)
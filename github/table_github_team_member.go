@@ -33,6 +33,7 @@ func gitHubTeamMemberColumns() []*plugin.Column {
 		{Name: "organization", Type: proto.ColumnType_STRING, Description: "The organization the team is associated with.", Transform: transform.FromQual("organization")},
 		{Name: "slug", Type: proto.ColumnType_STRING, Description: "The team slug name.", Transform: transform.FromQual("slug")},
 		{Name: "role", Type: proto.ColumnType_STRING, Description: "The team member's role (MEMBER, MAINTAINER)."},
+		{Name: "membership_state", Type: proto.ColumnType_STRING, Description: "The state of the user's membership of the team (active, pending).", Hydrate: hydrateTeamMembership, Transform: transform.FromField("State")},
 	}
 
 	cols = append(cols, sharedUserColumns()...)
@@ -57,7 +58,7 @@ func tableGitHubTeamMemberList(ctx context.Context, d *plugin.QueryData, h *plug
 						HasNextPage bool
 					}
 					Edges []models.TeamMemberWithRole
-				} `graphql:"members(first: $pageSize, after: $cursor)"`
+				} `graphql:"members(first: $pageSize, after: $cursor, role: $role)"`
 			} `graphql:"team(slug: $slug)"`
 		} `graphql:"organization(login: $login)"`
 	}
@@ -67,6 +68,11 @@ func tableGitHubTeamMemberList(ctx context.Context, d *plugin.QueryData, h *plug
 		"slug":     githubv4.String(slug),
 		"pageSize": githubv4.Int(pageSize),
 		"cursor":   (*githubv4.String)(nil),
+		"role":     (*githubv4.TeamMemberRole)(nil),
+	}
+	if role := quals["role"].GetStringValue(); role != "" {
+		r := githubv4.TeamMemberRole(strings.ToUpper(role))
+		variables["role"] = &r
 	}
 
 	client := connectV4(ctx, d)
@@ -98,3 +104,18 @@ func tableGitHubTeamMemberList(ctx context.Context, d *plugin.QueryData, h *plug
 
 	return nil, nil
 }
+
+func hydrateTeamMembership(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	member := h.Item.(models.TeamMemberWithRole)
+	org := d.EqualsQuals["organization"].GetStringValue()
+	slug := d.EqualsQuals["slug"].GetStringValue()
+
+	client := connect(ctx, d)
+	membership, _, err := client.Teams.GetTeamMembershipBySlug(ctx, org, slug, member.Node.Login)
+	if err != nil {
+		plugin.Logger(ctx).Error("github_team_member.hydrateTeamMembership", "api_error", err, "login", member.Node.Login)
+		return nil, err
+	}
+
+	return membership, nil
+}
@@ -0,0 +1,52 @@
+package github
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubTrafficPopularPath() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_traffic_popular_path",
+		Description: "Top 10 popular content paths over the last 14 days for the given repository.",
+		List: &plugin.ListConfig{
+			KeyColumns:        plugin.SingleColumn("repository_full_name"),
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubTrafficPopularPathList,
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromQual("repository_full_name"), Description: "Full name of the repository the path is in."},
+			{Name: "path", Type: proto.ColumnType_STRING, Description: "The path of the content, e.g. /turbot/steampipe/blob/main/README.md."},
+			{Name: "title", Type: proto.ColumnType_STRING, Description: "The title of the page for the path."},
+			{Name: "count", Type: proto.ColumnType_INT, Description: "View count for the path."},
+			{Name: "uniques", Type: proto.ColumnType_INT, Description: "Unique viewer count for the path."},
+		},
+	}
+}
+
+func tableGitHubTrafficPopularPathList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+	fullName := d.EqualsQuals["repository_full_name"].GetStringValue()
+	owner, repo := parseRepoFullName(fullName)
+
+	paths, _, err := client.Repositories.ListTrafficPaths(ctx, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range paths {
+		if i != nil {
+			d.StreamListItem(ctx, i)
+		}
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,51 @@
+package github
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubTrafficReferrer() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_traffic_referrer",
+		Description: "Top 10 referrers over the last 14 days for the given repository.",
+		List: &plugin.ListConfig{
+			KeyColumns:        plugin.SingleColumn("repository_full_name"),
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubTrafficReferrerList,
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromQual("repository_full_name"), Description: "Full name of the repository the referrer is for."},
+			{Name: "referrer", Type: proto.ColumnType_STRING, Description: "The referring site, e.g. Google or github.com."},
+			{Name: "count", Type: proto.ColumnType_INT, Description: "View count from the referrer."},
+			{Name: "uniques", Type: proto.ColumnType_INT, Description: "Unique viewer count from the referrer."},
+		},
+	}
+}
+
+func tableGitHubTrafficReferrerList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+	fullName := d.EqualsQuals["repository_full_name"].GetStringValue()
+	owner, repo := parseRepoFullName(fullName)
+
+	referrers, _, err := client.Repositories.ListTrafficReferrers(ctx, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range referrers {
+		if i != nil {
+			d.StreamListItem(ctx, i)
+		}
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+	return nil, nil
+}
@@ -21,7 +21,7 @@ func tableGitHubTrafficViewDaily() *plugin.Table {
 		},
 		Columns: []*plugin.Column{
 			// Top columns
-			{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromQual("repository_full_name"), Description: "Full name of the repository that contains the branch."},
+			{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromQual("repository_full_name"), Description: "Full name of the repository the traffic views are for."},
 			{Name: "timestamp", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Timestamp").Transform(convertTimestamp), Description: "Date for the view data."},
 			{Name: "count", Type: proto.ColumnType_INT, Description: "View count for the day."},
 			{Name: "uniques", Type: proto.ColumnType_INT, Description: "Unique viewer count for the day."},
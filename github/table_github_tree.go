@@ -13,7 +13,7 @@ import (
 func tableGitHubTree() *plugin.Table {
 	return &plugin.Table{
 		Name:        "github_tree",
-		Description: "Lists directories and files in the given repository's git tree.",
+		Description: "Lists directories and files in the given repository's git tree at a tree SHA or ref.",
 		List: &plugin.ListConfig{
 			Hydrate:           tableGitHubTreeList,
 			ShouldIgnoreError: isNotFoundError([]string{"404"}),
@@ -26,7 +26,7 @@ func tableGitHubTree() *plugin.Table {
 		Columns: []*plugin.Column{
 			// Top columns
 			{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromQual("repository_full_name"), Description: "Full name of the repository that contains the tree."},
-			{Name: "tree_sha", Type: proto.ColumnType_STRING, Transform: transform.FromQual("tree_sha"), Description: "SHA1 of the tree."},
+			{Name: "tree_sha", Type: proto.ColumnType_STRING, Transform: transform.FromQual("tree_sha"), Description: "SHA1 of the tree, or the name of a ref (branch or tag) whose tree to list."},
 			// Other columns
 			{Name: "recursive", Type: proto.ColumnType_BOOL, Description: "If set to true, return objects or subtrees referenced by the tree. Defaults to false."},
 			{Name: "truncated", Type: proto.ColumnType_BOOL, Description: "True if the entires were truncated because the number of items in the tree exceeded Github's maximum limit."},
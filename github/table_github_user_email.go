@@ -0,0 +1,60 @@
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v55/github"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubUserEmail() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_user_email",
+		Description: "Email addresses of the authenticated user.",
+		List: &plugin.ListConfig{
+			Hydrate: tableGitHubUserEmailList,
+		},
+		Columns: []*plugin.Column{
+			{Name: "email", Type: proto.ColumnType_STRING, Description: "The email address."},
+			{Name: "is_primary", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Primary"), Description: "If true, this is the primary email address of the user."},
+			{Name: "verified", Type: proto.ColumnType_BOOL, Description: "If true, the email address has been verified."},
+			{Name: "visibility", Type: proto.ColumnType_STRING, Description: "The visibility of the primary email address, either public or private. Returns null for other addresses."},
+		},
+	}
+}
+
+func tableGitHubUserEmailList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+
+	opts := &github.ListOptions{PerPage: 100}
+
+	for {
+		emails, resp, err := client.Users.ListEmails(ctx, opts)
+		if err != nil {
+			plugin.Logger(ctx).Error("github_user_email", "api_error", err)
+			return nil, err
+		}
+
+		for _, e := range emails {
+			if e != nil {
+				d.StreamListItem(ctx, e)
+			}
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return nil, nil
+}
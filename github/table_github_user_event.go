@@ -0,0 +1,81 @@
+package github
+
+import (
+	"context"
+
+	"github.com/google/go-github/v55/github"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func gitHubEventColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ID"), Description: "Unique ID of the event."},
+		{Name: "type", Type: proto.ColumnType_STRING, Description: "The type of the event, e.g. PushEvent, PullRequestEvent, IssuesEvent, ForkEvent or WatchEvent."},
+		{Name: "actor_login", Type: proto.ColumnType_STRING, Transform: transform.FromField("Actor.Login"), Description: "The login of the user who triggered the event."},
+		{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Repo.Name"), Description: "Full name of the repository the event occurred in."},
+		{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("CreatedAt").Transform(convertTimestamp), Description: "Time when the event occurred."},
+		{Name: "public", Type: proto.ColumnType_BOOL, Description: "If true, the event is visible publicly."},
+		{Name: "org_login", Type: proto.ColumnType_STRING, Transform: transform.FromField("Org.Login"), Description: "The login of the organization the event occurred in, if any."},
+		{Name: "payload", Type: proto.ColumnType_JSON, Transform: transform.FromField("RawPayload"), Description: "The payload of the event, which depends on the type of the event."},
+	}
+}
+
+func tableGitHubUserEvent() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_user_event",
+		Description: "Events performed by the given user, e.g. pushes, pull requests, issues, forks and stars.",
+		List: &plugin.ListConfig{
+			KeyColumns:        plugin.SingleColumn("login"),
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubUserEventList,
+		},
+		Columns: append([]*plugin.Column{
+			{Name: "login", Type: proto.ColumnType_STRING, Transform: transform.FromQual("login"), Description: "The login of the user who performed the events."},
+		}, gitHubEventColumns()...),
+	}
+}
+
+func tableGitHubUserEventList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+
+	login := d.EqualsQuals["login"].GetStringValue()
+	opts := &github.ListOptions{PerPage: 100}
+
+	limit := d.QueryContext.Limit
+	if limit != nil {
+		if *limit < int64(opts.PerPage) {
+			opts.PerPage = int(*limit)
+		}
+	}
+
+	for {
+		// Private events are only included when authenticated as the user
+		events, resp, err := client.Activity.ListEventsPerformedByUser(ctx, login, false, opts)
+		if err != nil {
+			plugin.Logger(ctx).Error("github_user_event", "api_error", err)
+			return nil, err
+		}
+
+		for _, e := range events {
+			if e != nil {
+				d.StreamListItem(ctx, e)
+			}
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return nil, nil
+}
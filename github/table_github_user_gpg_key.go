@@ -0,0 +1,87 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v55/github"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// The go-github GPGKey does not include whether the key has been revoked, so the keys are listed with a raw request
+type userGPGKey struct {
+	github.GPGKey
+	Revoked bool `json:"revoked"`
+}
+
+func tableGitHubUserGPGKey() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_user_gpg_key",
+		Description: "GPG keys of the given user.",
+		List: &plugin.ListConfig{
+			KeyColumns:        plugin.SingleColumn("login"),
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubUserGPGKeyList,
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "login", Type: proto.ColumnType_STRING, Transform: transform.FromQual("login"), Description: "The login of the user who owns the key."},
+			{Name: "id", Type: proto.ColumnType_INT, Transform: transform.FromField("ID"), Description: "Unique ID of the key."},
+			{Name: "key_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("KeyID"), Description: "The ID of the key, as shown by gpg."},
+			{Name: "emails", Type: proto.ColumnType_JSON, Description: "The email addresses of the key, and whether each has been verified with GitHub."},
+			{Name: "can_sign", Type: proto.ColumnType_BOOL, Description: "If true, the key can be used to sign commits."},
+			{Name: "expires_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("ExpiresAt").Transform(convertTimestamp), Description: "Time when the key expires. Returns null if the key does not expire."},
+			{Name: "revoked", Type: proto.ColumnType_BOOL, Description: "If true, the key has been revoked."},
+			// Other columns
+			{Name: "primary_key_id", Type: proto.ColumnType_INT, Transform: transform.FromField("PrimaryKeyID"), Description: "The ID of the primary key, for subkeys."},
+			{Name: "can_certify", Type: proto.ColumnType_BOOL, Description: "If true, the key can be used to certify other keys."},
+			{Name: "can_encrypt_comms", Type: proto.ColumnType_BOOL, Description: "If true, the key can be used to encrypt communications."},
+			{Name: "can_encrypt_storage", Type: proto.ColumnType_BOOL, Description: "If true, the key can be used to encrypt storage."},
+			{Name: "subkeys", Type: proto.ColumnType_JSON, Description: "The subkeys of the key."},
+			{Name: "public_key", Type: proto.ColumnType_STRING, Description: "The public key, base64 encoded."},
+			{Name: "raw_key", Type: proto.ColumnType_STRING, Description: "The ASCII armored public key."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("CreatedAt").Transform(convertTimestamp), Description: "Time when the key was added."},
+		},
+	}
+}
+
+func tableGitHubUserGPGKeyList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+
+	login := d.EqualsQuals["login"].GetStringValue()
+	page := 1
+
+	for {
+		req, err := client.NewRequest("GET", fmt.Sprintf("users/%v/gpg_keys?per_page=100&page=%v", login, page), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var keys []*userGPGKey
+		resp, err := client.Do(ctx, req, &keys)
+		if err != nil {
+			plugin.Logger(ctx).Error("github_user_gpg_key", "api_error", err)
+			return nil, err
+		}
+
+		for _, k := range keys {
+			d.StreamListItem(ctx, k)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		page = resp.NextPage
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,133 @@
+package github
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+
+	"github.com/google/go-github/v55/github"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type userSSHKey struct {
+	ID        int64
+	Usage     string
+	Title     *string
+	Key       string
+	CreatedAt *github.Timestamp
+}
+
+func tableGitHubUserSSHKey() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_user_ssh_key",
+		Description: "Public SSH authentication and signing keys of the given user.",
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "login", Require: plugin.Required},
+				{Name: "usage", Require: plugin.Optional},
+			},
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubUserSSHKeyList,
+		},
+		Columns: []*plugin.Column{
+			// Top columns
+			{Name: "login", Type: proto.ColumnType_STRING, Transform: transform.FromQual("login"), Description: "The login of the user who owns the key."},
+			{Name: "id", Type: proto.ColumnType_INT, Transform: transform.FromField("ID"), Description: "Unique ID of the key."},
+			{Name: "usage", Type: proto.ColumnType_STRING, Description: "What the key is used for, either authentication or signing."},
+			{Name: "key_type", Type: proto.ColumnType_STRING, Transform: transform.FromField("Key").Transform(sshKeyType), Description: "The type of the key, e.g. ssh-ed25519 or ssh-rsa."},
+			{Name: "fingerprint", Type: proto.ColumnType_STRING, Transform: transform.FromField("Key").Transform(sshKeyFingerprint), Description: "The SHA256 fingerprint of the key."},
+			// Other columns
+			{Name: "title", Type: proto.ColumnType_STRING, Description: "The title of the key. Only returned for signing keys, or for the authenticated user's own keys."},
+			{Name: "key", Type: proto.ColumnType_STRING, Description: "The public key."},
+			{Name: "created_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("CreatedAt").Transform(convertTimestamp), Description: "Time when the key was added. Only returned for signing keys, or for the authenticated user's own keys."},
+		},
+	}
+}
+
+func tableGitHubUserSSHKeyList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client := connect(ctx, d)
+
+	quals := d.EqualsQuals
+	login := quals["login"].GetStringValue()
+	usage := quals["usage"].GetStringValue()
+
+	if usage == "" || usage == "authentication" {
+		opts := &github.ListOptions{PerPage: 100}
+		for {
+			keys, resp, err := client.Users.ListKeys(ctx, login, opts)
+			if err != nil {
+				plugin.Logger(ctx).Error("github_user_ssh_key", "api_error", err)
+				return nil, err
+			}
+
+			for _, k := range keys {
+				d.StreamListItem(ctx, userSSHKey{ID: k.GetID(), Usage: "authentication", Title: k.Title, Key: k.GetKey(), CreatedAt: k.CreatedAt})
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+
+			if resp.NextPage == 0 {
+				break
+			}
+
+			opts.Page = resp.NextPage
+		}
+	}
+
+	if usage == "" || usage == "signing" {
+		opts := &github.ListOptions{PerPage: 100}
+		for {
+			keys, resp, err := client.Users.ListSSHSigningKeys(ctx, login, opts)
+			if err != nil {
+				plugin.Logger(ctx).Error("github_user_ssh_key", "api_error", err)
+				return nil, err
+			}
+
+			for _, k := range keys {
+				d.StreamListItem(ctx, userSSHKey{ID: k.GetID(), Usage: "signing", Title: k.Title, Key: k.GetKey(), CreatedAt: k.CreatedAt})
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+
+			if resp.NextPage == 0 {
+				break
+			}
+
+			opts.Page = resp.NextPage
+		}
+	}
+
+	return nil, nil
+}
+
+func sshKeyType(_ context.Context, input *transform.TransformData) (interface{}, error) {
+	fields := strings.Fields(input.Value.(string))
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	return fields[0], nil
+}
+
+// sshKeyFingerprint returns the fingerprint of the key in the format used by ssh-keygen and GitHub
+func sshKeyFingerprint(_ context.Context, input *transform.TransformData) (interface{}, error) {
+	fields := strings.Fields(input.Value.(string))
+	if len(fields) < 2 {
+		return nil, nil
+	}
+	data, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return nil, nil
+	}
+	sum := sha256.Sum256(data)
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,78 @@
+package github
+
+import (
+	"context"
+	"github.com/shurcooL/githubv4"
+	"github.com/turbot/steampipe-plugin-github/github/models"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func tableGitHubWatcher() *plugin.Table {
+	return &plugin.Table{
+		Name:        "github_watcher",
+		Description: "Watchers are users who are subscribed to notifications for the repository.",
+		List: &plugin.ListConfig{
+			KeyColumns:        plugin.SingleColumn("repository_full_name"),
+			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate:           tableGitHubWatcherList,
+		},
+		Columns: []*plugin.Column{
+			{Name: "repository_full_name", Type: proto.ColumnType_STRING, Transform: transform.FromQual("repository_full_name"), Description: "Full name of the repository that is being watched."},
+			{Name: "user_login", Type: proto.ColumnType_STRING, Transform: transform.FromField("Login"), Description: "The login name of the user who is watching the repository."},
+			{Name: "user_detail", Type: proto.ColumnType_JSON, Transform: transform.FromValue(), Description: "Details of the user who is watching the repository."},
+		},
+	}
+}
+
+func tableGitHubWatcherList(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	fullName := d.EqualsQuals["repository_full_name"].GetStringValue()
+	owner, repo := parseRepoFullName(fullName)
+
+	var query struct {
+		RateLimit  models.RateLimit
+		Repository struct {
+			Watchers struct {
+				TotalCount int
+				PageInfo   models.PageInfo
+				Nodes      []models.BasicUser
+			} `graphql:"watchers(first: $pageSize, after: $cursor)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+
+	pageSize := adjustPageSize(100, d.QueryContext.Limit)
+	variables := map[string]interface{}{
+		"owner":    githubv4.String(owner),
+		"repo":     githubv4.String(repo),
+		"pageSize": githubv4.Int(pageSize),
+		"cursor":   (*githubv4.String)(nil),
+	}
+
+	client := connectV4(ctx, d)
+	for {
+		err := client.Query(ctx, &query, variables)
+		plugin.Logger(ctx).Debug(rateLimitLogString("github_watcher", &query.RateLimit))
+		if err != nil {
+			plugin.Logger(ctx).Error("github_watcher", "api_error", err)
+			return nil, err
+		}
+
+		for _, w := range query.Repository.Watchers.Nodes {
+			d.StreamListItem(ctx, w)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if !query.Repository.Watchers.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.Repository.Watchers.PageInfo.EndCursor)
+	}
+
+	return nil, nil
+}
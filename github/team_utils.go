@@ -0,0 +1,48 @@
+package github
+
+import (
+	"context"
+	"slices"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/turbot/steampipe-plugin-github/github/models"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func appendTeamColumnIncludes(m *map[string]interface{}, cols []string) {
+	(*m)["includeTeamAncestors"] = githubv4.Boolean(slices.Contains(cols, "ancestor_team_slugs"))
+	(*m)["includeTeamChildTeams"] = githubv4.Boolean(slices.Contains(cols, "child_team_slugs"))
+	(*m)["includeTeamDescendants"] = githubv4.Boolean(slices.Contains(cols, "descendant_team_slugs"))
+}
+
+// teamSlugs converts a list of teams to a list of their slugs
+func teamSlugs(_ context.Context, input *transform.TransformData) (interface{}, error) {
+	teams, ok := input.Value.([]models.TeamSlug)
+	if !ok || len(teams) == 0 {
+		return nil, nil
+	}
+
+	slugs := make([]string, 0, len(teams))
+	for _, team := range teams {
+		slugs = append(slugs, team.Slug)
+	}
+	return slugs, nil
+}
+
+// teamHydrateIdpGroups returns the identity provider groups mapped to the team with team synchronization. Team
+// synchronization is only available to organizations on GitHub Enterprise Cloud, for other organizations null is returned.
+func teamHydrateIdpGroups(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	team := h.Item.(models.TeamWithCounts)
+	client := connect(ctx, d)
+
+	groups, _, err := client.Teams.ListIDPGroupsForTeamBySlug(ctx, team.Organization.Login, team.Slug)
+	if err != nil {
+		if isNotFoundError([]string{"403", "404"})(err) {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("github_team.teamHydrateIdpGroups", "api_error", err)
+		return nil, err
+	}
+	return groups.Groups, nil
+}
@@ -0,0 +1,164 @@
+package github
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// tokenPool rotates requests across several personal access tokens, so the rate limits of all of them are available
+// to a connection. A token which has exhausted its rate limit, or reached the min_rate_limit_remaining budget, is
+// skipped until the limit resets, and a request which was rejected for being rate limited is retried with the next
+// token.
+type tokenPool struct {
+	base         http.RoundTripper
+	tokens       []string
+	minRemaining int
+
+	mu   sync.Mutex
+	next int
+	// resetAt is the time the rate limit of each token resets, by rate limit resource, once it has been exhausted
+	resetAt []map[string]time.Time
+}
+
+func newTokenPool(tokens []string, minRemaining int, base http.RoundTripper) *tokenPool {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	resetAt := make([]map[string]time.Time, len(tokens))
+	for i := range resetAt {
+		resetAt[i] = map[string]time.Time{}
+	}
+	return &tokenPool{base: base, tokens: tokens, minRemaining: minRemaining, resetAt: resetAt}
+}
+
+func (p *tokenPool) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resource := rateLimitResource(req)
+	for attempt := 0; ; attempt++ {
+		i := p.pick(resource)
+
+		retry := req.Clone(req.Context())
+		if body != nil {
+			retry.Body = io.NopCloser(bytes.NewReader(body))
+			retry.ContentLength = int64(len(body))
+		}
+		retry.Header.Set("Authorization", "Bearer "+p.tokens[i])
+
+		resp, err := p.base.RoundTrip(retry)
+		if err != nil {
+			return resp, err
+		}
+		remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+		if err != nil || remaining > p.minRemaining {
+			return resp, nil
+		}
+
+		// The token has exhausted its rate limit, so it is skipped by later
+		// requests until the limit resets
+		reset := time.Now().Add(time.Minute)
+		if epoch, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+			reset = time.Unix(epoch, 0)
+		}
+		p.exhausted(i, resource, reset)
+
+		limited, err := isRateLimitedResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+		if !limited || attempt == len(p.tokens)-1 || !p.available(resource) {
+			return resp, nil
+		}
+
+		plugin.Logger(req.Context()).Debug("tokenPool.RoundTrip", "rate_limited_token", i, "resource", resource, "reset", reset)
+		resp.Body.Close()
+	}
+}
+
+// pick returns the next token in the rotation which has not exhausted its rate limit, or the token whose limit
+// resets first if all of them have
+func (p *tokenPool) pick(resource string) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	soonest := p.next
+	for n := 0; n < len(p.tokens); n++ {
+		i := (p.next + n) % len(p.tokens)
+		if reset, ok := p.resetAt[i][resource]; !ok || now.After(reset) {
+			p.next = (i + 1) % len(p.tokens)
+			return i
+		}
+		if p.resetAt[i][resource].Before(p.resetAt[soonest][resource]) {
+			soonest = i
+		}
+	}
+	return soonest
+}
+
+func (p *tokenPool) exhausted(i int, resource string, reset time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.resetAt[i][resource] = reset
+}
+
+// available returns true if any token has not exhausted its rate limit for the resource
+func (p *tokenPool) available(resource string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	for i := range p.tokens {
+		if reset, ok := p.resetAt[i][resource]; !ok || now.After(reset) {
+			return true
+		}
+	}
+	return false
+}
+
+// rateLimitResource returns the rate limit resource the request counts against. The REST search API has a separate
+// rate limit to the rest of the REST API.
+func rateLimitResource(req *http.Request) string {
+	switch {
+	case strings.HasSuffix(req.URL.Path, "/graphql"):
+		return "graphql"
+	case strings.Contains(req.URL.Path, "/search/"):
+		return "search"
+	default:
+		return "core"
+	}
+}
+
+// isRateLimitedResponse returns true if the request was rejected for exceeding the primary rate limit. The REST API
+// responds with a 403 or 429 status, while the GraphQL API responds with a RATE_LIMITED error.
+func isRateLimitedResponse(resp *http.Response) (bool, error) {
+	if resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests {
+		return true, nil
+	}
+	if resp.StatusCode != http.StatusOK || !strings.HasSuffix(resp.Request.URL.Path, "/graphql") {
+		return false, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return false, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return bytes.Contains(body, []byte(`"RATE_LIMITED"`)), nil
+}
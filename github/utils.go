@@ -2,10 +2,19 @@ package github
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
-	"net/url"
+	"net/http"
 	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/turbot/steampipe-plugin-github/github/models"
@@ -22,8 +31,10 @@ import (
 // Create Rest API (v3) client
 func connect(ctx context.Context, d *plugin.QueryData) *github.Client {
 
-	// Load connection from cache, which preserves throttling protection etc
-	cacheKey := "github_v3"
+	// Load connection from cache, which preserves throttling protection etc.
+	// Each table has its own client so that the API cost of its scans is
+	// recorded separately.
+	cacheKey := "github_v3_" + d.Table.Name
 	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
 		return cachedData.(*github.Client)
 	}
@@ -40,35 +51,24 @@ func connect(ctx context.Context, d *plugin.QueryData) *github.Client {
 		baseURL = *githubConfig.BaseURL
 	}
 
-	if token == "" {
-		panic("'token' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")
-	}
-
-	ts := oauth2.StaticTokenSource(
-		&oauth2.Token{AccessToken: token},
-	)
-	tc := oauth2.NewClient(ctx, ts)
+	tc := getHTTPClient(ctx, d, githubConfig, token, baseURL)
 	conn := github.NewClient(tc)
 
 	// If the base URL was provided then set it on the client. Used for
 	// enterprise installs.
 	if baseURL != "" {
-		uv4, err := url.Parse(baseURL)
+		restURL, _, err := enterpriseAPIURLs(baseURL)
 		if err != nil {
-			panic(fmt.Sprintf("github.base_url is invalid: %s", baseURL))
-		}
-
-		if uv4.String() != "https://api.github.com/" {
-			uv4.Path = uv4.Path + "api/v3/"
+			panic(err.Error())
 		}
 
 		// The upload URL is not set as it's not currently required
-		conn, err = github.NewClient(tc).WithEnterpriseURLs(uv4.String(), "")
+		conn, err = github.NewClient(tc).WithEnterpriseURLs(restURL.String(), "")
 		if err != nil {
 			panic(fmt.Sprintf("error creating GitHub client: %v", err))
 		}
 
-		conn.BaseURL = uv4
+		conn.BaseURL = restURL
 	}
 
 	// Save to cache
@@ -77,11 +77,165 @@ func connect(ctx context.Context, d *plugin.QueryData) *github.Client {
 	return conn
 }
 
+// getHTTPClient returns the authenticated HTTP client used by the REST and GraphQL clients. Requests are rotated
+// across the tokens of a token pool if several tokens are configured, and are retried after backing off if they hit a
+// secondary rate limit. Responses are served from the disk cache if one is configured. The API cost of the requests
+// is recorded against the table of the query.
+func getHTTPClient(ctx context.Context, d *plugin.QueryData, config githubConfig, token string, baseURL string) *http.Client {
+	var transport http.RoundTripper = newSecondaryRateLimitTransport(config, getAuthTransport(ctx, d, config, token, baseURL))
+	if config.DiskCacheDir != nil && *config.DiskCacheDir != "" && !diskCacheExcludedTables[d.Table.Name] {
+		cache, err := newDiskCache(config, d.Connection.Name, credentialIdentity(d, config, token, baseURL), transport)
+		if err != nil {
+			panic(fmt.Sprintf("unable to create disk_cache_dir: %v. Edit your connection configuration file and then restart Steampipe", err))
+		}
+		transport = cache
+	}
+
+	return &http.Client{
+		Transport: &queryCostTransport{
+			base: transport,
+			cost: getQueryCost(d.Connection.Name, d.Table.Name),
+		},
+	}
+}
+
+// credentialIdentity returns a hash identifying the credentials of the connection: the GitHub App installation, or
+// the tokens the requests are authenticated with
+func credentialIdentity(d *plugin.QueryData, config githubConfig, token string, baseURL string) string {
+	var parts []string
+	if creds, _ := getAppCredentials(config); creds != nil {
+		parts = []string{"app", strconv.FormatInt(creds.AppID, 10), strconv.FormatInt(creds.InstallationID, 10)}
+	} else {
+		if token != "" {
+			parts = append(parts, token)
+		}
+		parts = append(parts, config.Tokens...)
+		if len(parts) == 0 {
+			// The token is resolved from the environment, the gh CLI or the credential helper
+			resolved, err := getTokenSource(d, config, token, baseURL).Token()
+			if err == nil {
+				parts = append(parts, resolved.AccessToken)
+			}
+		}
+	}
+
+	hash := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(hash[:])
+}
+
+func getAuthTransport(ctx context.Context, d *plugin.QueryData, config githubConfig, token string, baseURL string) http.RoundTripper {
+	// A GitHub App installation takes precedence over personal access tokens
+	tokens := config.Tokens
+	if token != "" {
+		tokens = append([]string{token}, tokens...)
+	}
+	var base http.RoundTripper = getETagCache(d)
+	if budget := getRateLimitBudget(d, config, base); budget != nil {
+		base = budget
+	}
+	if creds, _ := getAppCredentials(config); creds != nil || len(tokens) < 2 {
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, &http.Client{Transport: base})
+		return oauth2.NewClient(ctx, getTokenSource(d, config, token, baseURL)).Transport
+	}
+
+	// The token pool is shared by the REST and GraphQL clients so that they
+	// skip the same rate limited tokens
+	cacheKey := "github_token_pool"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(*tokenPool)
+	}
+	minRemaining := 0
+	if config.MinRateLimitRemaining != nil {
+		minRemaining = *config.MinRateLimitRemaining
+	}
+	pool := newTokenPool(tokens, minRemaining, base)
+	d.ConnectionManager.Cache.Set(cacheKey, pool)
+	return pool
+}
+
+// getRateLimitBudget returns the transport which keeps the min_rate_limit_remaining budget of each token, or nil if
+// no budget is configured. It is shared by the REST and GraphQL clients so that they track the same rate limits.
+func getRateLimitBudget(d *plugin.QueryData, config githubConfig, base http.RoundTripper) *rateLimitBudget {
+	if config.MinRateLimitRemaining == nil || *config.MinRateLimitRemaining <= 0 {
+		return nil
+	}
+
+	cacheKey := "github_rate_limit_budget"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(*rateLimitBudget)
+	}
+	waitForReset := config.WaitForReset != nil && *config.WaitForReset
+	budget := newRateLimitBudget(*config.MinRateLimitRemaining, waitForReset, base)
+	d.ConnectionManager.Cache.Set(cacheKey, budget)
+	return budget
+}
+
+// getETagCache returns the cache of the REST responses of the connection, which is shared by the clients of all tables
+func getETagCache(d *plugin.QueryData) *etagCache {
+	cacheKey := "github_etag_cache"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(*etagCache)
+	}
+	cache := newETagCache(nil)
+	d.ConnectionManager.Cache.Set(cacheKey, cache)
+	return cache
+}
+
+// getTokenSource returns the source of the token used by both the REST and GraphQL clients. A GitHub App installation
+// token is used if an app is configured, otherwise the personal access token.
+func getTokenSource(d *plugin.QueryData, config githubConfig, token string, baseURL string) oauth2.TokenSource {
+	creds, err := getAppCredentials(config)
+	if err != nil {
+		panic(fmt.Sprintf("%v. Edit your connection configuration file and then restart Steampipe", err))
+	}
+
+	if creds != nil {
+		// The installation token source is shared by the REST and GraphQL
+		// clients so that they refresh the same token
+		cacheKey := "github_installation_token_source"
+		if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+			return cachedData.(oauth2.TokenSource)
+		}
+		ts := newInstallationTokenSource(creds, baseURL)
+		d.ConnectionManager.Cache.Set(cacheKey, ts)
+		return ts
+	}
+
+	if token == "" && len(config.Tokens) > 0 {
+		token = config.Tokens[0]
+	}
+	if token == "" {
+		// The fallback token is resolved once per connection as it may run
+		// external commands
+		cacheKey := "github_fallback_token"
+		if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+			token = cachedData.(string)
+		} else {
+			token, err = resolveFallbackToken(config, baseURL)
+			if err != nil {
+				panic(fmt.Sprintf("%v. Edit your connection configuration file and then restart Steampipe", err))
+			}
+			if token != "" {
+				d.ConnectionManager.Cache.Set(cacheKey, token)
+			}
+		}
+	}
+	if token == "" {
+		panic("'token' must be set in the connection configuration, or in the GITHUB_TOKEN or GH_TOKEN environment variables, or be available from the gh CLI or the credential_helper command. Edit your connection configuration file and then restart Steampipe")
+	}
+
+	return oauth2.StaticTokenSource(
+		&oauth2.Token{AccessToken: token},
+	)
+}
+
 // Create GraphQL API (v4) client
 func connectV4(ctx context.Context, d *plugin.QueryData) *githubv4.Client {
 
-	// Load connection from cache, which preserves throttling protection etc
-	cacheKey := "github_v4"
+	// Load connection from cache, which preserves throttling protection etc.
+	// Each table has its own client so that the API cost of its scans is
+	// recorded separately.
+	cacheKey := "github_v4_" + d.Table.Name
 	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
 		return cachedData.(*githubv4.Client)
 	}
@@ -98,29 +252,24 @@ func connectV4(ctx context.Context, d *plugin.QueryData) *githubv4.Client {
 		baseURL = *githubConfig.BaseURL
 	}
 
-	if token == "" {
-		panic("'token' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")
-	}
-
-	ts := oauth2.StaticTokenSource(
-		&oauth2.Token{AccessToken: token},
-	)
-	tc := oauth2.NewClient(ctx, ts)
+	tc := getHTTPClient(ctx, d, githubConfig, token, baseURL)
 	conn := githubv4.NewClient(tc)
 
 	// If the base URL was provided then set it on the client. Used for
 	// enterprise installs.
 	if baseURL != "" {
-		uv4, err := url.Parse(baseURL)
+		_, graphqlURL, err := enterpriseAPIURLs(baseURL)
 		if err != nil {
-			panic(fmt.Sprintf("github.base_url is invalid: %s", baseURL))
+			panic(err.Error())
 		}
 
-		if uv4.String() != "https://api.github.com/" {
-			uv4.Path = uv4.Path + "api/graphql"
+		// GitHub Enterprise Server instances may not support all of the
+		// fields of the GitHub cloud schema
+		if graphqlURL.Host != "api.github.com" {
+			tc.Transport = &enterpriseServerTransport{base: tc.Transport}
 		}
 
-		conn = githubv4.NewEnterpriseClient(uv4.String(), tc)
+		conn = githubv4.NewEnterpriseClient(graphqlURL.String(), tc)
 	}
 
 	// Save to cache
@@ -131,6 +280,37 @@ func connectV4(ctx context.Context, d *plugin.QueryData) *githubv4.Client {
 
 //// HELPER FUNCTIONS
 
+// getRawJSON returns the REST API object at the URL as it was returned, so that the fields of the object which the
+// plugin does not model are available. The URL is either a path relative to the REST API root or an absolute API URL.
+func getRawJSON(ctx context.Context, d *plugin.QueryData, urlStr string) (json.RawMessage, error) {
+	client := connect(ctx, d)
+
+	req, err := client.NewRequest("GET", urlStr, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw json.RawMessage
+	_, err = client.Do(ctx, req, &raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return raw, nil
+}
+
+// expandHomeDir replaces a leading ~ in the path with the home directory of the user
+func expandHomeDir(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, path[1:]), nil
+}
+
 func parseRepoFullName(fullName string) (string, string) {
 	owner := ""
 	repo := ""
@@ -142,6 +322,327 @@ func parseRepoFullName(fullName string) (string, string) {
 	return owner, repo
 }
 
+// repositoryFullNamesFromQuals returns the full names of the repositories matched by the repository_full_name qual,
+// which is either an exact full name or a LIKE pattern on the repositories of a single owner, e.g. 'turbot/steampipe-%'.
+// IN lists are expanded by the SDK, which calls the list function once per value. Without the qual the repositories in
+// the scopes of the connection config are returned.
+func repositoryFullNamesFromQuals(ctx context.Context, d *plugin.QueryData) ([]string, error) {
+	if d.Quals["repository_full_name"] == nil {
+		return configuredRepositoryFullNames(ctx, d)
+	}
+
+	// The quals are AND-ed, so the repositories must match each of them.
+	// Full names are compared case-insensitively, as GitHub does.
+	var fullNames []string
+	first := true
+	for _, q := range d.Quals["repository_full_name"].Quals {
+		value := q.Value.GetStringValue()
+		var matches []string
+		switch q.Operator {
+		case "=":
+			matches = []string{value}
+		case "~~":
+			var err error
+			matches, err = listRepositoryFullNamesLike(ctx, d, value)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			continue
+		}
+
+		if first {
+			fullNames = matches
+			first = false
+			continue
+		}
+		matched := map[string]bool{}
+		for _, match := range matches {
+			matched[strings.ToLower(match)] = true
+		}
+		fullNames = slices.DeleteFunc(fullNames, func(fullName string) bool {
+			return !matched[strings.ToLower(fullName)]
+		})
+	}
+
+	seen := map[string]bool{}
+	return slices.DeleteFunc(fullNames, func(fullName string) bool {
+		key := strings.ToLower(fullName)
+		if seen[key] {
+			return true
+		}
+		seen[key] = true
+		return false
+	}), nil
+}
+
+// matchesRepositoryFullNameQuals returns whether the repository matches the equality repository_full_name quals, for
+// rows which are looked up without listing the repositories of the quals
+func matchesRepositoryFullNameQuals(d *plugin.QueryData, fullName string) bool {
+	if d.Quals["repository_full_name"] == nil {
+		return true
+	}
+	for _, q := range d.Quals["repository_full_name"].Quals {
+		if q.Operator == "=" && !strings.EqualFold(q.Value.GetStringValue(), fullName) {
+			return false
+		}
+	}
+	return true
+}
+
+// configuredRepositoryFullNames returns the full names of the repositories in the orgs and repos of the connection
+// config, less those matching exclude_repos. A repos entry is either a full name or a glob pattern on the repositories of
+// a literal owner, e.g. 'turbot/steampipe-plugin-*', which is expanded by listing the repositories of the owner.
+func configuredRepositoryFullNames(ctx context.Context, d *plugin.QueryData) ([]string, error) {
+	config := GetConfig(d.Connection)
+	if len(config.Orgs) == 0 && len(config.Repos) == 0 {
+		return nil, fmt.Errorf("the repository must be given with 'repository_full_name' in the where or join clause, or orgs or repos must be set in the connection config")
+	}
+
+	var candidates []string
+	for _, org := range config.Orgs {
+		ownerFullNames, err := listOwnerRepositoryFullNames(ctx, d, org)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, ownerFullNames...)
+	}
+
+	for _, repo := range config.Repos {
+		owner, name := parseRepoFullName(repo)
+		if name == "" || strings.ContainsAny(owner, repositoryGlobMeta) {
+			return nil, fmt.Errorf("repos entries must be a full name or a pattern on the repositories of a literal owner, e.g. 'turbot/steampipe-*' - the connection config has '%s'", repo)
+		}
+		if !strings.ContainsAny(name, repositoryGlobMeta) {
+			candidates = append(candidates, repo)
+			continue
+		}
+
+		ownerFullNames, err := listOwnerRepositoryFullNames(ctx, d, owner)
+		if err != nil {
+			return nil, err
+		}
+		for _, fullName := range ownerFullNames {
+			matched, err := matchRepositoryGlob(repo, fullName)
+			if err != nil {
+				return nil, err
+			}
+			if matched {
+				candidates = append(candidates, fullName)
+			}
+		}
+	}
+
+	seen := map[string]bool{}
+	var fullNames []string
+	for _, fullName := range candidates {
+		if seen[strings.ToLower(fullName)] {
+			continue
+		}
+		seen[strings.ToLower(fullName)] = true
+
+		excluded := false
+		for _, pattern := range config.ExcludeRepos {
+			matched, err := matchRepositoryGlob(pattern, fullName)
+			if err != nil {
+				return nil, err
+			}
+			if matched {
+				excluded = true
+				break
+			}
+		}
+		if !excluded {
+			fullNames = append(fullNames, fullName)
+		}
+	}
+
+	return fullNames, nil
+}
+
+// repositoryGlobMeta are the characters which make a repos or exclude_repos entry a glob pattern
+const repositoryGlobMeta = "*?["
+
+// matchRepositoryGlob returns true if the full name matches the glob pattern, ignoring case as GitHub does
+func matchRepositoryGlob(pattern string, fullName string) (bool, error) {
+	matched, err := path.Match(strings.ToLower(pattern), strings.ToLower(fullName))
+	if err != nil {
+		return false, fmt.Errorf("invalid repository pattern '%s' in the connection config: %w", pattern, err)
+	}
+	return matched, nil
+}
+
+// defaultRepositoryConcurrency is the number of repositories listed at once by a scan of several repositories, unless
+// set by repository_concurrency
+const defaultRepositoryConcurrency = 5
+
+// forEachRepository calls list for each of the repositories, listing up to repository_concurrency of them at once.
+// The rate limits are respected by the transports of the clients, which delay or retry the requests. The listings stop
+// at the first error, which is returned, or once the limit of the query has been hit.
+func forEachRepository(ctx context.Context, d *plugin.QueryData, fullNames []string, list func(ctx context.Context, fullName string) error) error {
+	concurrency := defaultRepositoryConcurrency
+	if config := GetConfig(d.Connection); config.RepositoryConcurrency != nil && *config.RepositoryConcurrency > 0 {
+		concurrency = *config.RepositoryConcurrency
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+	sem := make(chan struct{}, concurrency)
+
+	for _, fullName := range fullNames {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			break
+		}
+
+		wg.Add(1)
+		go func(fullName string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			// Errors of listings interrupted by the cancellation are ignored
+			if err := list(ctx, fullName); err != nil && ctx.Err() == nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}(fullName)
+	}
+
+	wg.Wait()
+	return firstErr
+}
+
+func listRepositoryFullNamesLike(ctx context.Context, d *plugin.QueryData, pattern string) ([]string, error) {
+	owner, _ := parseRepoFullName(pattern)
+	if owner == pattern || strings.ContainsAny(owner, "%_\\") {
+		return nil, fmt.Errorf("repository_full_name patterns must start with a literal owner, e.g. 'turbot/steampipe-%%' - you attempted to filter for '%s'", pattern)
+	}
+
+	re, err := regexp.Compile(likePatternToRegexp(pattern))
+	if err != nil {
+		return nil, err
+	}
+
+	ownerFullNames, err := listOwnerRepositoryFullNames(ctx, d, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	var fullNames []string
+	for _, fullName := range ownerFullNames {
+		if re.MatchString(fullName) {
+			fullNames = append(fullNames, fullName)
+		}
+	}
+
+	return fullNames, nil
+}
+
+func listOwnerRepositoryFullNamesUncached(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var query struct {
+		RateLimit       models.RateLimit
+		RepositoryOwner struct {
+			Repositories struct {
+				PageInfo models.PageInfo
+				Nodes    []struct {
+					NameWithOwner string
+				}
+			} `graphql:"repositories(first: $pageSize, after: $cursor)"`
+		} `graphql:"repositoryOwner(login: $owner)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner":    githubv4.String(h.Item.(string)),
+		"pageSize": githubv4.Int(100),
+		"cursor":   (*githubv4.String)(nil),
+	}
+
+	client := connectV4(ctx, d)
+
+	var fullNames []string
+	for {
+		err := client.Query(ctx, &query, variables)
+		plugin.Logger(ctx).Debug(rateLimitLogString("listOwnerRepositoryFullNames", &query.RateLimit))
+		if err != nil {
+			plugin.Logger(ctx).Error("listOwnerRepositoryFullNames", "api_error", err)
+			return nil, err
+		}
+
+		for _, repo := range query.RepositoryOwner.Repositories.Nodes {
+			fullNames = append(fullNames, repo.NameWithOwner)
+		}
+
+		if !query.RepositoryOwner.Repositories.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = githubv4.NewString(query.RepositoryOwner.Repositories.PageInfo.EndCursor)
+	}
+
+	return fullNames, nil
+}
+
+// likePatternToRegexp converts a SQL LIKE pattern to an anchored regular expression
+func likePatternToRegexp(pattern string) string {
+	var b strings.Builder
+	b.WriteString("^")
+	escaped := false
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '%':
+			b.WriteString(".*")
+		case r == '_':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+	return b.String()
+}
+
+// timeRangePosition returns -1 if the time is before the range given by the quals, 1 if it is after the range and 0 if
+// it is within the range
+func timeRangePosition(t time.Time, kq *plugin.KeyColumnQuals) int {
+	for _, q := range kq.Quals {
+		givenTime := q.Value.GetTimestampValue().AsTime()
+		switch q.Operator {
+		case ">":
+			if !t.After(givenTime) {
+				return -1
+			}
+		case ">=":
+			if t.Before(givenTime) {
+				return -1
+			}
+		case "<":
+			if !t.Before(givenTime) {
+				return 1
+			}
+		case "<=":
+			if t.After(givenTime) {
+				return 1
+			}
+		}
+	}
+	return 0
+}
+
 func adjustPageSize(pageSize int, limit *int64) int {
 	if limit != nil && *limit < int64(pageSize) {
 		return int(*limit)